@@ -1,6 +1,7 @@
 package nfl
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
@@ -11,3 +12,12 @@ func TestEmbed(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, UserAccountIsAllSetup, content)
 }
+
+func TestFlowJSONWithoutAccounts(t *testing.T) {
+	var config map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(FlowJSON, &config))
+	assert.NotContains(t, config, "accounts")
+	assert.Contains(t, config, "contracts")
+	assert.Contains(t, config, "deployments")
+	assert.NotContains(t, string(FlowJSON), "resourceID")
+}
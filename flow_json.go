@@ -0,0 +1,25 @@
+package nfl
+
+import (
+	"encoding/json"
+)
+
+// FlowJSON is the flow.json project configuration, including the contract
+// aliases for each network. Its accounts section, which holds the account
+// keys, is left out.
+var FlowJSON = withoutAccounts(flowJSON)
+
+// withoutAccounts returns the flow.json configuration in data without its
+// accounts section
+func withoutAccounts(data []byte) []byte {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(data, &config); err != nil {
+		panic(err)
+	}
+	delete(config, "accounts")
+	stripped, err := json.Marshal(config)
+	if err != nil {
+		panic(err)
+	}
+	return stripped
+}
@@ -0,0 +1,3 @@
+package nfl
+
+//go:generate go run ./internal/gen
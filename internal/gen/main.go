@@ -0,0 +1,449 @@
+// Command gen regenerates embed.go, the lib/go/templates loaders and the
+// lib/go/builders builders from the Cadence files under contracts/,
+// transactions/ and scripts/.
+//
+// Run it with go generate from the repository root.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const header = "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n"
+
+// embedNameOverrides keeps the names of embed variables that were exported
+// before they were generated
+var embedNameOverrides = map[string]string{
+	"transactions/admin/nfts/mint_moment_nfts_multi.cdc": "NftsBatchMintMomentNfts",
+	"transactions/user/setup_all_collections.cdc":        "UserSetUpAllCollections",
+}
+
+// unprefixedGroups are directories whose embed variables are not prefixed
+// with the directory name
+var unprefixedGroups = map[string]bool{
+	"badges": true,
+}
+
+// groupTitles are the section headings of the generated loaders
+var groupTitles = map[string]string{
+	"":     "Contracts",
+	"nfts": "Moment NFTs",
+}
+
+// embedWords and templateWords spell out the words that are not simply
+// title-cased in embed variable and loader names
+var (
+	embedWords = map[string]string{
+		"id":     "ID",
+		"ids":    "IDs",
+		"allday": "AllDay",
+	}
+	templateWords = map[string]string{
+		"id":     "ID",
+		"ids":    "IDs",
+		"nft":    "NFT",
+		"nfts":   "NFTs",
+		"allday": "AllDay",
+	}
+)
+
+// cadenceFile is a Cadence file found in the repository
+type cadenceFile struct {
+	Path  string
+	Kind  string
+	Group string
+	name  string
+}
+
+// EmbedName is the name of the embed variable in package nfl
+func (f cadenceFile) EmbedName() string {
+	if name, ok := embedNameOverrides[f.Path]; ok {
+		return name
+	}
+	switch {
+	case f.Kind == "Contract":
+		return f.name + "Contract"
+	case unprefixedGroups[f.Group]:
+		return camelCase(f.name, embedWords)
+	default:
+		return camelCase(f.Group, embedWords) + camelCase(f.name, embedWords)
+	}
+}
+
+// TemplateName is the name of the loader in package templates
+func (f cadenceFile) TemplateName() string {
+	if f.Kind == "Contract" {
+		return "Generate" + f.name + "Contract"
+	}
+	return "Generate" + camelCase(f.name, templateWords) + f.Kind
+}
+
+// BuilderName is the name of the method in package builders
+func (f cadenceFile) BuilderName() string {
+	return strings.TrimSuffix(strings.TrimPrefix(f.TemplateName(), "Generate"), f.Kind)
+}
+
+func camelCase(s string, words map[string]string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(s, "_") {
+		if spelled, ok := words[word]; ok {
+			b.WriteString(spelled)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// group is a section of the generated loaders
+type group struct {
+	Title string
+	Files []cadenceFile
+}
+
+func groupTitle(name string) string {
+	if title, ok := groupTitles[name]; ok {
+		return title
+	}
+	return camelCase(name, templateWords)
+}
+
+// groupFiles splits files into sections by directory, keeping their order
+func groupFiles(files []cadenceFile) []group {
+	var groups []group
+	for _, file := range files {
+		title := groupTitle(file.Group)
+		if len(groups) == 0 || groups[len(groups)-1].Title != title {
+			groups = append(groups, group{Title: title})
+		}
+		groups[len(groups)-1].Files = append(groups[len(groups)-1].Files, file)
+	}
+	return groups
+}
+
+// findFiles walks the Cadence directories under root
+func findFiles(root string) ([]cadenceFile, error) {
+	var files []cadenceFile
+
+	contracts, err := filepath.Glob(filepath.Join(root, "contracts", "*.cdc"))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range contracts {
+		name := strings.TrimSuffix(filepath.Base(p), ".cdc")
+		files = append(files, cadenceFile{
+			Path: path.Join("contracts", filepath.Base(p)),
+			Kind: "Contract",
+			name: name,
+		})
+	}
+
+	for _, dir := range []string{"transactions", "scripts"} {
+		err := filepath.WalkDir(filepath.Join(root, dir), func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(p) != ".cdc" {
+				return err
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			parts := strings.Split(rel, "/")
+			file := cadenceFile{
+				Path:  rel,
+				Kind:  "Script",
+				Group: parts[len(parts)-2],
+				name:  strings.TrimSuffix(parts[len(parts)-1], ".cdc"),
+			}
+			if dir == "transactions" {
+				file.Kind = "Transaction"
+			}
+			files = append(files, file)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].Kind != files[j].Kind {
+			return files[i].Kind < files[j].Kind
+		}
+		return files[i].Path < files[j].Path
+	})
+
+	seen := map[string]string{}
+	for _, file := range files {
+		names := []string{file.EmbedName(), file.TemplateName()}
+		if file.Kind != "Contract" {
+			names = append(names, "builders."+file.BuilderName())
+		}
+		for _, name := range names {
+			if other, ok := seen[name]; ok {
+				return nil, fmt.Errorf("%s and %s both generate %s", other, file.Path, name)
+			}
+			seen[name] = file.Path
+		}
+	}
+
+	return files, nil
+}
+
+func filesOfKind(files []cadenceFile, kind string) []cadenceFile {
+	var result []cadenceFile
+	for _, file := range files {
+		if file.Kind == kind {
+			result = append(result, file)
+		}
+	}
+	return result
+}
+
+var embedTemplate = template.Must(template.New("embed").Parse(`package nfl
+
+import (
+	_ "embed"
+)
+
+// flowJSON is the flow.json project configuration. FlowJSON exposes it
+// without its accounts.
+//
+//go:embed flow.json
+var flowJSON []byte
+
+// Contracts is a list of the contracts deployed by the AllDay account
+var (
+{{- range .Contracts}}
+	//go:embed {{.Path}}
+	{{.EmbedName}} []byte
+{{- end}}
+)
+
+// scripts is a list of all the scripts we export with imports mapped
+var (
+{{- range $i, $group := .Scripts}}
+{{- if $i}}
+{{end}}
+{{- range $group.Files}}
+	//go:embed {{.Path}}
+	{{.EmbedName}} []byte
+{{- end}}
+{{- end}}
+)
+
+// Transactions is a list of all the transactions we export with imports mapped
+var (
+{{- range $i, $group := .Transactions}}
+{{- if $i}}
+{{end}}
+{{- range $group.Files}}
+	//go:embed {{.Path}}
+	{{.EmbedName}} []byte
+{{- end}}
+{{- end}}
+)
+
+// Paths of the embedded files relative to the repository root
+const (
+{{- range .All}}
+	{{.EmbedName}}Path = "{{.Path}}"
+{{- end}}
+)
+`))
+
+var loadersTemplate = template.Must(template.New("loaders").Parse(`package templates
+
+import (
+	nfl "github.com/dapperlabs/nfl-smart-contracts"
+)
+{{range .}}
+// ------------------------------------------------------------
+// {{.Title}}
+// ------------------------------------------------------------
+{{- range .Files}}
+func {{.TemplateName}}(env Environment) []byte {
+	return ReplaceAddresses(nfl.{{.EmbedName}}, env)
+}
+{{end}}
+{{- end}}`))
+
+// builder is a transaction or script and the signature of its builder
+type builder struct {
+	cadenceFile
+	signature
+}
+
+var buildersTemplate = template.Must(template.New("builders").Parse(`package builders
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+{{if .Imports}}
+{{end}}
+{{- range .LocalImports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Groups}}
+// ------------------------------------------------------------
+// {{.Title}}
+// ------------------------------------------------------------
+{{- range .Builders}}
+{{- if eq .Kind "Transaction"}}
+
+// {{.BuilderName}} builds {{.Path}}
+{{- if .Signers}}
+//
+// Authorizers: {{range $i, $signer := .Signers}}{{if $i}}, {{end}}{{$signer}}{{end}}
+{{- end}}
+func (b *Builder) {{.BuilderName}}({{.GoParameters}}) (*flow.Transaction, error) {
+	return transaction(
+		templates.{{.TemplateName}}(b.env),
+{{- range .Parameters}}
+		{{.Encoder}}({{.Name}}),
+{{- end}}
+	)
+}
+{{- else}}
+
+// {{.BuilderName}} builds {{.Path}}
+func (b *Builder) {{.BuilderName}}({{.GoParameters}}) Script {
+	return script(
+		templates.{{.TemplateName}}(b.env),
+{{- range .Parameters}}
+		{{.Encoder}}({{.Name}}),
+{{- end}}
+	)
+}
+{{- end}}
+{{- end}}
+{{end}}`))
+
+// builderGroup is a section of the generated builders
+type builderGroup struct {
+	Title    string
+	Builders []builder
+}
+
+func renderBuilders(root string, files []cadenceFile) ([]byte, error) {
+	imports := map[string]bool{
+		"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates": true,
+	}
+	var groups []builderGroup
+	for _, g := range groupFiles(files) {
+		group := builderGroup{Title: g.Title}
+		for _, file := range g.Files {
+			sig, err := parseSignature(root, file)
+			if err != nil {
+				return nil, err
+			}
+			if file.Kind == "Transaction" {
+				imports[packages["flow"]] = true
+			}
+			sig.imports(imports)
+			group.Builders = append(group.Builders, builder{cadenceFile: file, signature: sig})
+		}
+		groups = append(groups, group)
+	}
+	var external, local []string
+	for path := range imports {
+		if strings.HasPrefix(path, "github.com/dapperlabs/nfl-smart-contracts/") {
+			local = append(local, path)
+		} else {
+			external = append(external, path)
+		}
+	}
+	sort.Strings(external)
+	sort.Strings(local)
+	return render(buildersTemplate, map[string]any{
+		"Imports":      external,
+		"LocalImports": local,
+		"Groups":       groups,
+	})
+}
+
+func render(t *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// generate returns the contents of every generated file, keyed by its path
+// relative to root
+func generate(root string) (map[string][]byte, error) {
+	files, err := findFiles(root)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := filesOfKind(files, "Contract")
+	scripts := filesOfKind(files, "Script")
+	transactions := filesOfKind(files, "Transaction")
+
+	outputs := map[string][]byte{}
+	outputs["embed.go"], err = render(embedTemplate, map[string]any{
+		"Contracts":    contracts,
+		"Scripts":      groupFiles(scripts),
+		"Transactions": groupFiles(transactions),
+		"All":          files,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loaders := map[string][]cadenceFile{
+		"lib/go/templates/contract_templates.go":    contracts,
+		"lib/go/templates/script_templates.go":      scripts,
+		"lib/go/templates/transaction_templates.go": transactions,
+	}
+	for p, files := range loaders {
+		outputs[p], err = render(loadersTemplate, groupFiles(files))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	builders := map[string][]cadenceFile{
+		"lib/go/builders/script_builders.go":      scripts,
+		"lib/go/builders/transaction_builders.go": transactions,
+	}
+	for p, files := range builders {
+		outputs[p], err = renderBuilders(root, files)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputs, nil
+}
+
+func main() {
+	root := flag.String("root", ".", "repository root")
+	flag.Parse()
+
+	outputs, err := generate(*root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for p, content := range outputs {
+		if err := os.WriteFile(filepath.Join(*root, p), content, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
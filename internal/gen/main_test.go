@@ -0,0 +1,75 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const root = "../.."
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	outputs, err := generate(root)
+	require.NoError(t, err)
+
+	for p, want := range outputs {
+		got, err := os.ReadFile(filepath.Join(root, p))
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), "%s is out of date, run go generate", p)
+	}
+}
+
+func TestNames(t *testing.T) {
+	files, err := findFiles(root)
+	require.NoError(t, err)
+
+	byPath := map[string]cadenceFile{}
+	for _, file := range files {
+		byPath[file.Path] = file
+	}
+
+	cases := []struct {
+		path     string
+		embed    string
+		template string
+		builder  string
+	}{
+		{"contracts/AllDay.cdc", "AllDayContract", "GenerateAllDayContract", ""},
+		{"scripts/editions/read_all_editions.cdc", "EditionsReadAllEditions", "GenerateReadAllEditionsScript", "ReadAllEditions"},
+		{"scripts/nfts/read_collection_nft_ids.cdc", "NftsReadCollectionNftIDs", "GenerateReadCollectionNFTIDsScript", "ReadCollectionNFTIDs"},
+		{"scripts/badges/get_nft_all_badges.cdc", "GetNftAllBadges", "GenerateGetNFTAllBadgesScript", "GetNFTAllBadges"},
+		{"transactions/user/setup_allday_account.cdc", "UserSetupAllDayAccount", "GenerateSetupAllDayAccountTransaction", "SetupAllDayAccount"},
+		{"transactions/admin/nfts/mint_moment_nfts_multi.cdc", "NftsBatchMintMomentNfts", "GenerateMintMomentNFTsMultiTransaction", "MintMomentNFTsMulti"},
+	}
+	for _, c := range cases {
+		file, ok := byPath[c.path]
+		require.True(t, ok, c.path)
+		assert.Equal(t, c.embed, file.EmbedName())
+		assert.Equal(t, c.template, file.TemplateName())
+		if c.builder != "" {
+			assert.Equal(t, c.builder, file.BuilderName())
+		}
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	sig, err := parseSignature(root, cadenceFile{
+		Path: "transactions/admin/editions/create_edition.cdc",
+		Kind: "Transaction",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"signer"}, sig.Signers)
+	assert.Equal(t, "seriesID, setID, playID uint64, tier model.Tier, parallel *model.Parallel, maxMintSize *uint64", sig.GoParameters())
+	assert.Equal(t, "optional(uint64Value)", sig.Parameters[5].Encoder)
+
+	sig, err = parseSignature(root, cadenceFile{
+		Path: "scripts/nfts/read_moment_nft_properties.cdc",
+		Kind: "Script",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sig.Signers)
+	assert.Equal(t, "address flow.Address, id uint64", sig.GoParameters())
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser"
+)
+
+// nominalTypes maps the Cadence types allowed in parameter lists to their Go
+// type and the builders function that encodes it
+var nominalTypes = map[string]struct{ goType, encoder string }{
+	"UInt64":  {"uint64", "uint64Value"},
+	"String":  {"string", "stringValue"},
+	"Bool":    {"bool", "boolValue"},
+	"Address": {"flow.Address", "addressValue"},
+}
+
+// namedTypes maps parameters that take one of the model types to their Go
+// type and encoder, keyed by parameter name and then Cadence type
+var namedTypes = map[string]map[string]struct{ goType, encoder string }{
+	"tier":     {"String": {"model.Tier", "tierValue"}},
+	"parallel": {"String": {"model.Parallel", "parallelValue"}},
+}
+
+// packages maps the qualifiers used in Go parameter types to their import paths
+var packages = map[string]string{
+	"flow":  "github.com/onflow/flow-go-sdk",
+	"model": "github.com/dapperlabs/nfl-smart-contracts/lib/go/model",
+}
+
+// parameter is a transaction or script parameter
+type parameter struct {
+	Name   string
+	GoType string
+	// Encoder is an expression for a func(GoType) cadence.Value
+	Encoder string
+}
+
+// signature is the parameter list of a transaction or script, and the
+// accounts a transaction is authorized by
+type signature struct {
+	Parameters []parameter
+	Signers    []string
+}
+
+// GoParameters returns the Go parameter list, with consecutive parameters of
+// the same type grouped
+func (s signature) GoParameters() string {
+	var groups []string
+	for i, p := range s.Parameters {
+		if i+1 < len(s.Parameters) && s.Parameters[i+1].GoType == p.GoType {
+			groups = append(groups, p.Name)
+			continue
+		}
+		groups = append(groups, p.Name+" "+p.GoType)
+	}
+	return strings.Join(groups, ", ")
+}
+
+// imports adds the import paths of the parameter types to paths
+func (s signature) imports(paths map[string]bool) {
+	for _, p := range s.Parameters {
+		for qualifier, path := range packages {
+			if strings.Contains(p.GoType, qualifier+".") {
+				paths[path] = true
+			}
+		}
+	}
+}
+
+// parseSignature parses the Cadence file at path and returns its parameters
+func parseSignature(root string, file cadenceFile) (signature, error) {
+	code, err := os.ReadFile(filepath.Join(root, file.Path))
+	if err != nil {
+		return signature{}, err
+	}
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return signature{}, fmt.Errorf("%s: %w", file.Path, err)
+	}
+
+	var params *ast.ParameterList
+	var sig signature
+	switch file.Kind {
+	case "Transaction":
+		tx := program.SoleTransactionDeclaration()
+		if tx == nil {
+			return signature{}, fmt.Errorf("%s: no transaction declaration", file.Path)
+		}
+		params = tx.ParameterList
+		if tx.Prepare != nil {
+			for _, p := range tx.Prepare.FunctionDeclaration.ParameterList.Parameters {
+				sig.Signers = append(sig.Signers, p.Identifier.Identifier)
+			}
+		}
+	case "Script":
+		for _, fn := range program.FunctionDeclarations() {
+			if fn.Identifier.Identifier == "main" {
+				params = fn.ParameterList
+			}
+		}
+		if params == nil {
+			return signature{}, fmt.Errorf("%s: no main function", file.Path)
+		}
+	}
+
+	if params == nil {
+		return sig, nil
+	}
+	for _, p := range params.Parameters {
+		goType, encoder, err := goTypeOf(p.TypeAnnotation.Type, namedTypes[p.Identifier.Identifier])
+		if err != nil {
+			return signature{}, fmt.Errorf("%s: parameter %s: %w", file.Path, p.Identifier.Identifier, err)
+		}
+		name := p.Identifier.Identifier
+		if token.IsKeyword(name) || name == "b" {
+			name += "_"
+		}
+		sig.Parameters = append(sig.Parameters, parameter{
+			Name:    name,
+			GoType:  goType,
+			Encoder: encoder,
+		})
+	}
+	return sig, nil
+}
+
+// goTypeOf returns the Go type and encoder for a Cadence parameter type,
+// preferring the overrides for nominal types
+func goTypeOf(t ast.Type, overrides map[string]struct{ goType, encoder string }) (string, string, error) {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		if override, ok := overrides[t.String()]; ok {
+			return override.goType, override.encoder, nil
+		}
+		nominal, ok := nominalTypes[t.String()]
+		if !ok {
+			return "", "", fmt.Errorf("unsupported type %s", t)
+		}
+		return nominal.goType, nominal.encoder, nil
+	case *ast.OptionalType:
+		goType, encoder, err := goTypeOf(t.Type, overrides)
+		if err != nil {
+			return "", "", err
+		}
+		return "*" + goType, "optional(" + encoder + ")", nil
+	case *ast.VariableSizedType:
+		goType, encoder, err := goTypeOf(t.Type, overrides)
+		if err != nil {
+			return "", "", err
+		}
+		return "[]" + goType, "array(" + encoder + ")", nil
+	case *ast.DictionaryType:
+		keyType, keyEncoder, err := goTypeOf(t.KeyType, nil)
+		if err != nil {
+			return "", "", err
+		}
+		valueType, valueEncoder, err := goTypeOf(t.ValueType, nil)
+		if err != nil {
+			return "", "", err
+		}
+		return "map[" + keyType + "]" + valueType, "dictionary(" + keyEncoder + ", " + valueEncoder + ")", nil
+	default:
+		return "", "", fmt.Errorf("unsupported type %s", t)
+	}
+}
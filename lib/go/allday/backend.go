@@ -0,0 +1,54 @@
+package allday
+
+import (
+	"context"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+)
+
+// Backend is the chain a Client reads from and submits transactions to. The
+// lib/go/test package has an implementation backed by an in-process emulator.
+type Backend interface {
+	// ExecuteScript executes script against the latest sealed state and
+	// returns its result, or an error if it failed
+	ExecuteScript(ctx context.Context, script builders.Script) (cadence.Value, error)
+
+	// SendTransaction submits a signed transaction. It returns an error if
+	// the transaction was rejected, not if its execution failed.
+	SendTransaction(ctx context.Context, tx flow.Transaction) error
+
+	// GetTransactionResult returns the current result of the transaction
+	// with id, which is not final until its status is sealed or expired
+	GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error)
+}
+
+// AccessBackend is a Backend backed by a Flow access node
+type AccessBackend struct {
+	client access.Client
+}
+
+// NewAccessBackend returns a Backend that sends requests to an access node
+// through client, such as one created by the SDK's grpc or http packages
+func NewAccessBackend(client access.Client) *AccessBackend {
+	return &AccessBackend{client: client}
+}
+
+// ExecuteScript executes script at the latest sealed block
+func (b *AccessBackend) ExecuteScript(ctx context.Context, script builders.Script) (cadence.Value, error) {
+	return b.client.ExecuteScriptAtLatestBlock(ctx, script.Code, script.Arguments)
+}
+
+// SendTransaction sends tx to the access node
+func (b *AccessBackend) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	return b.client.SendTransaction(ctx, tx)
+}
+
+// GetTransactionResult returns the access node's result for the transaction
+// with id
+func (b *AccessBackend) GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error) {
+	return b.client.GetTransactionResult(ctx, id)
+}
@@ -0,0 +1,248 @@
+// Package allday reads the AllDay contract's state through its scripts and
+// submits its transactions.
+//
+// A Client runs the same scripts and transactions against an access node or
+// an emulator, depending on its Backend:
+//
+//	client := allday.NewClient(allday.NewAccessBackend(flowClient), env)
+//	edition, err := client.GetEdition(ctx, editionID)
+//	result, err := client.Submit(ctx, signedTx)
+package allday
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/views"
+)
+
+const (
+	defaultPollInterval    = 250 * time.Millisecond
+	defaultMaxPollInterval = 5 * time.Second
+	defaultPageSize        = 200
+)
+
+// Client reads AllDay state from a backend and submits transactions to it
+type Client struct {
+	backend         Backend
+	builder         *builders.Builder
+	decoder         *events.Decoder
+	pollInterval    time.Duration
+	maxPollInterval time.Duration
+	pageSize        uint64
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithPollInterval sets how long Submit waits before polling a transaction's
+// result again. The wait starts at initial and doubles up to max.
+func WithPollInterval(initial, max time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = initial
+		c.maxPollInterval = max
+	}
+}
+
+// WithPageSize sets how many entities each script reads when a query pages
+// through them, which keeps each script under the computation limit. A
+// size of zero keeps the default.
+func WithPageSize(size uint64) Option {
+	return func(c *Client) {
+		if size > 0 {
+			c.pageSize = size
+		}
+	}
+}
+
+// NewClient returns a Client that runs scripts on backend with imports
+// resolved for env, and decodes the events env's contracts emit
+func NewClient(backend Backend, env templates.Environment, options ...Option) *Client {
+	c := &Client{
+		backend:         backend,
+		builder:         builders.New(env),
+		decoder:         events.NewDecoder(env),
+		pollInterval:    defaultPollInterval,
+		maxPollInterval: defaultMaxPollInterval,
+		pageSize:        defaultPageSize,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Backend returns the backend the client runs scripts on
+func (c *Client) Backend() Backend {
+	return c.backend
+}
+
+// Builder returns the builder the client builds scripts with
+func (c *Client) Builder() *builders.Builder {
+	return c.builder
+}
+
+// execute runs script and decodes its result with decode, naming the
+// operation in errors and classifying the script's failure
+func execute[T any](ctx context.Context, c *Client, operation string, script builders.Script, decode func(cadence.Value) (T, error)) (T, error) {
+	var zero T
+	value, err := c.backend.ExecuteScript(ctx, script)
+	if err != nil {
+		return zero, fmt.Errorf("allday: %s: %w", operation, classify(err))
+	}
+	result, err := decode(value)
+	if err != nil {
+		return zero, fmt.Errorf("allday: %s: %w", operation, err)
+	}
+	return result, nil
+}
+
+// decodeAs decodes a value with the codec
+func decodeAs[T any](value cadence.Value) (T, error) {
+	var result T
+	err := codec.Decode(value, &result)
+	return result, err
+}
+
+// ------------------------------------------------------------
+// Series
+// ------------------------------------------------------------
+
+// GetSeries returns the series with id
+func (c *Client) GetSeries(ctx context.Context, id uint64) (model.Series, error) {
+	return execute(ctx, c, fmt.Sprintf("get series %d", id), c.builder.ReadSeriesByID(id), model.DecodeSeries)
+}
+
+// GetSeriesByName returns the series named name
+func (c *Client) GetSeriesByName(ctx context.Context, name string) (model.Series, error) {
+	return execute(ctx, c, fmt.Sprintf("get series %q", name), c.builder.ReadSeriesByName(name), model.DecodeSeries)
+}
+
+// AllSeriesNames returns the names of every series
+func (c *Client) AllSeriesNames(ctx context.Context) ([]string, error) {
+	return execute(ctx, c, "get series names", c.builder.ReadAllSeriesNames(), decodeAs[[]string])
+}
+
+// ------------------------------------------------------------
+// Sets
+// ------------------------------------------------------------
+
+// GetSet returns the set with id
+func (c *Client) GetSet(ctx context.Context, id uint64) (model.Set, error) {
+	return execute(ctx, c, fmt.Sprintf("get set %d", id), c.builder.ReadSetByID(id), model.DecodeSet)
+}
+
+// ------------------------------------------------------------
+// Plays
+// ------------------------------------------------------------
+
+// GetPlay returns the play with id
+func (c *Client) GetPlay(ctx context.Context, id uint64) (model.Play, error) {
+	return execute(ctx, c, fmt.Sprintf("get play %d", id), c.builder.ReadPlayByID(id), model.DecodePlay)
+}
+
+// ------------------------------------------------------------
+// Editions
+// ------------------------------------------------------------
+
+// GetEdition returns the edition with id, including its parallel
+func (c *Client) GetEdition(ctx context.Context, id uint64) (model.Edition, error) {
+	return execute(ctx, c, fmt.Sprintf("get edition %d", id), c.builder.ReadEditionByID(id), model.DecodeEdition)
+}
+
+// AllEditions returns every edition. Their Parallel is empty, as
+// AllDay.EditionData only exposes it through getParallel.
+func (c *Client) AllEditions(ctx context.Context) ([]model.Edition, error) {
+	return execute(ctx, c, "get editions", c.builder.ReadAllEditions(), model.DecodeEditions)
+}
+
+// NextEditionID returns the ID the next edition will be created with. Every
+// edition has an ID from 1 up to but not including it.
+func (c *Client) NextEditionID(ctx context.Context) (uint64, error) {
+	return execute(ctx, c, "get next edition ID", c.builder.ReadNextEditionID(), decodeAs[uint64])
+}
+
+// FindEditionID returns the ID of the edition with the set, play, tier and
+// parallel, or nil if there is none. A nil parallel or ParallelStandard finds
+// the edition created without a parallel. It returns
+// ErrEditionIDMapNotMigrated for an edition missing from the contract's
+// edition ID map.
+func (c *Client) FindEditionID(ctx context.Context, setID, playID uint64, tier model.Tier, parallel *model.Parallel) (*uint64, error) {
+	if parallel != nil {
+		parallel = parallel.Optional()
+	}
+	operation := fmt.Sprintf("find edition with set %d, play %d and tier %s", setID, playID, tier)
+	return execute(ctx, c, operation, c.builder.ReadEditionIDBySetPlayTierParallel(setID, playID, tier, parallel), decodeAs[*uint64])
+}
+
+// ------------------------------------------------------------
+// Moment NFTs
+// ------------------------------------------------------------
+
+// GetMoment returns the moment with id in owner's collection
+func (c *Client) GetMoment(ctx context.Context, owner flow.Address, id uint64) (model.Moment, error) {
+	return execute(ctx, c, fmt.Sprintf("get moment %d of %s", id, owner.HexWithPrefix()), c.builder.ReadMomentNFTProperties(owner, id), model.DecodeMoment)
+}
+
+// GetMomentMetadata returns the MetadataViews the moment with id in owner's
+// collection resolves
+func (c *Client) GetMomentMetadata(ctx context.Context, owner flow.Address, id uint64) (views.MomentMetadata, error) {
+	return execute(ctx, c, fmt.Sprintf("get metadata of moment %d of %s", id, owner.HexWithPrefix()), c.builder.ReadMomentNFTMetadata(owner, id), views.DecodeMomentMetadata)
+}
+
+// TotalSupply returns the number of moments in existence
+func (c *Client) TotalSupply(ctx context.Context) (uint64, error) {
+	return execute(ctx, c, "get total supply", c.builder.ReadMomentNFTSupply(), decodeAs[uint64])
+}
+
+// IsAccountSetup reports whether address has an AllDay collection to receive
+// moments in
+func (c *Client) IsAccountSetup(ctx context.Context, address flow.Address) (bool, error) {
+	return execute(ctx, c, fmt.Sprintf("check account %s", address.HexWithPrefix()), c.builder.AccountIsSetup(address), decodeAs[bool])
+}
+
+// ------------------------------------------------------------
+// Badges
+// ------------------------------------------------------------
+
+// GetBadge returns the badge with slug, or nil if there is none
+func (c *Client) GetBadge(ctx context.Context, slug string) (*model.Badge, error) {
+	return execute(ctx, c, fmt.Sprintf("get badge %q", slug), c.builder.GetBadgeBySlug(slug), model.DecodeOptionalBadge)
+}
+
+// AllBadges returns every badge sorted by slug, or only the visible ones if
+// visibleOnly is true
+func (c *Client) AllBadges(ctx context.Context, visibleOnly bool) ([]model.Badge, error) {
+	badges, err := execute(ctx, c, "get badges", c.builder.GetAllBadges(visibleOnly), model.DecodeBadges)
+	slices.SortFunc(badges, func(a, b model.Badge) int { return cmp.Compare(a.Slug, b.Slug) })
+	return badges, err
+}
+
+// GetBadgeEntities returns the entities a badge is attached to, by type and ID
+func (c *Client) GetBadgeEntities(ctx context.Context, slug string) ([]model.BadgeEntity, error) {
+	entities, err := execute(ctx, c, fmt.Sprintf("get entities of badge %q", slug), c.builder.GetBadgeEntities(slug), model.DecodeBadgeEntities)
+	slices.SortFunc(entities, func(a, b model.BadgeEntity) int {
+		return cmp.Or(cmp.Compare(a.EntityType, b.EntityType), cmp.Compare(a.EntityID, b.EntityID))
+	})
+	return entities, err
+}
+
+// GetEntityBadgeSlugs returns the slugs of the badges attached to an entity,
+// sorted. They include badges deleted since they were attached, which the
+// contract keeps attached.
+func (c *Client) GetEntityBadgeSlugs(ctx context.Context, entityType string, entityID uint64) ([]string, error) {
+	slugs, err := execute(ctx, c, fmt.Sprintf("get badges of %s %d", entityType, entityID), c.builder.GetEntityBadgeSlugs(entityType, entityID), decodeAs[[]string])
+	slices.Sort(slugs)
+	return slugs, err
+}
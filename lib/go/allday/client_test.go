@@ -0,0 +1,189 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+)
+
+// fakeBackend returns a fixed result, or the result of execute if set, and
+// records the scripts it executes. Transaction results are returned in
+// order, repeating the last one.
+type fakeBackend struct {
+	result       cadence.Value
+	err          error
+	execute      func(builders.Script) (cadence.Value, error)
+	scripts      []builders.Script
+	transactions []flow.Transaction
+	txResults    []*flow.TransactionResult
+	polls        int
+}
+
+func (f *fakeBackend) ExecuteScript(_ context.Context, script builders.Script) (cadence.Value, error) {
+	f.scripts = append(f.scripts, script)
+	if f.execute != nil {
+		return f.execute(script)
+	}
+	return f.result, f.err
+}
+
+func (f *fakeBackend) SendTransaction(_ context.Context, tx flow.Transaction) error {
+	f.transactions = append(f.transactions, tx)
+	return f.err
+}
+
+func (f *fakeBackend) GetTransactionResult(_ context.Context, _ flow.Identifier) (*flow.TransactionResult, error) {
+	result := f.txResults[min(f.polls, len(f.txResults)-1)]
+	f.polls++
+	return result, nil
+}
+
+var testEnv = templates.Environment{AllDayAddress: "e4cf4bdc1751c65d"}
+
+func seriesValue() cadence.Value {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.String("Series One"),
+		cadence.NewBool(true),
+	}).WithType(cadence.NewStructType(nil, "AllDay.SeriesData", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "name", Type: cadence.StringType},
+		{Identifier: "active", Type: cadence.BoolType},
+	}, nil))
+}
+
+func TestGetSeries(t *testing.T) {
+	backend := &fakeBackend{result: seriesValue()}
+	client := NewClient(backend, testEnv)
+
+	series, err := client.GetSeriesByName(context.Background(), "Series One")
+	require.NoError(t, err)
+	assert.Equal(t, model.Series{ID: 1, Name: "Series One", Active: true}, series)
+
+	require.Len(t, backend.scripts, 1)
+	assert.Equal(t, builders.New(testEnv).ReadSeriesByName("Series One"), backend.scripts[0])
+}
+
+func TestScalarResults(t *testing.T) {
+	backend := &fakeBackend{result: cadence.NewUInt64(42)}
+	client := NewClient(backend, testEnv)
+
+	supply, err := client.TotalSupply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), supply)
+
+	backend.result = cadence.NewArray([]cadence.Value{cadence.String("Series One"), cadence.String("Series Two")})
+	names, err := client.AllSeriesNames(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Series One", "Series Two"}, names)
+
+	backend.result = cadence.NewOptional(nil)
+	badge, err := client.GetBadge(context.Background(), "rookie")
+	require.NoError(t, err)
+	assert.Nil(t, badge)
+}
+
+func TestFindEditionID(t *testing.T) {
+	backend := &fakeBackend{result: cadence.NewOptional(cadence.NewUInt64(7))}
+	client := NewClient(backend, testEnv)
+	b := builders.New(testEnv)
+
+	id, err := client.FindEditionID(context.Background(), 3, 17, model.TierRare, model.ParallelRuby.Optional())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), *id)
+
+	// the standard parallel is passed as nil, as create_edition.cdc takes it
+	parallel := model.ParallelStandard
+	backend.result = cadence.NewOptional(nil)
+	id, err = client.FindEditionID(context.Background(), 3, 17, model.TierRare, &parallel)
+	require.NoError(t, err)
+	assert.Nil(t, id)
+
+	assert.Equal(t, []builders.Script{
+		b.ReadEditionIDBySetPlayTierParallel(3, 17, model.TierRare, model.ParallelRuby.Optional()),
+		b.ReadEditionIDBySetPlayTierParallel(3, 17, model.TierRare, nil),
+	}, backend.scripts)
+}
+
+func badgeValue(slug string, visible bool) cadence.Value {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.String(slug),
+		cadence.String(slug),
+		cadence.String(""),
+		cadence.NewBool(visible),
+		cadence.String(slug + "-v2"),
+		cadence.NewDictionary(nil),
+	}).WithType(cadence.NewStructType(nil, "AllDay.Badge", []cadence.Field{
+		{Identifier: "slug", Type: cadence.StringType},
+		{Identifier: "title", Type: cadence.StringType},
+		{Identifier: "description", Type: cadence.StringType},
+		{Identifier: "visible", Type: cadence.BoolType},
+		{Identifier: "slugV2", Type: cadence.StringType},
+		{Identifier: "metadata", Type: cadence.NewDictionaryType(cadence.StringType, cadence.StringType)},
+	}, nil))
+}
+
+func badgeEntityValue(entityType string, entityID uint64) cadence.Value {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.String(entityType),
+		cadence.NewUInt64(entityID),
+		cadence.NewDictionary([]cadence.KeyValuePair{{Key: cadence.String("level"), Value: cadence.String(entityType)}}),
+	}).WithType(cadence.NewStructType(nil, "AllDay.BadgeEntity", []cadence.Field{
+		{Identifier: "entityType", Type: cadence.StringType},
+		{Identifier: "entityID", Type: cadence.UInt64Type},
+		{Identifier: "metadata", Type: cadence.NewDictionaryType(cadence.StringType, cadence.StringType)},
+	}, nil))
+}
+
+func TestBadgeLists(t *testing.T) {
+	backend := &fakeBackend{result: cadence.NewArray([]cadence.Value{badgeValue("rookie", true), badgeValue("mvp", false)})}
+	client := NewClient(backend, testEnv)
+	b := builders.New(testEnv)
+
+	badges, err := client.AllBadges(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, badges, 2)
+	assert.Equal(t, "mvp", badges[0].Slug)
+	assert.Equal(t, "rookie", badges[1].Slug)
+
+	backend.result = cadence.NewArray([]cadence.Value{
+		badgeEntityValue("play", 3),
+		badgeEntityValue("moment", 9),
+		badgeEntityValue("edition", 2),
+		badgeEntityValue("play", 1),
+	})
+	entities, err := client.GetBadgeEntities(context.Background(), "rookie")
+	require.NoError(t, err)
+	assert.Equal(t, []model.BadgeEntity{
+		{EntityType: "edition", EntityID: 2, Metadata: map[string]string{"level": "edition"}},
+		{EntityType: "moment", EntityID: 9, Metadata: map[string]string{"level": "moment"}},
+		{EntityType: "play", EntityID: 1, Metadata: map[string]string{"level": "play"}},
+		{EntityType: "play", EntityID: 3, Metadata: map[string]string{"level": "play"}},
+	}, entities)
+
+	assert.Equal(t, []builders.Script{b.GetAllBadges(true), b.GetBadgeEntities("rookie")}, backend.scripts)
+}
+
+func TestClientErrors(t *testing.T) {
+	failed := errors.New("Cannot borrow series, no such id")
+	backend := &fakeBackend{err: failed}
+	client := NewClient(backend, testEnv)
+
+	_, err := client.GetSeries(context.Background(), 9)
+	assert.ErrorIs(t, err, failed)
+	assert.EqualError(t, err, "allday: get series 9: Cannot borrow series, no such id")
+
+	backend.err = nil
+	backend.result = cadence.String("not a series")
+	_, err = client.GetSeries(context.Background(), 9)
+	assert.EqualError(t, err, "allday: get series 9: model: decode SeriesData: codec: cannot decode String into model.Series")
+}
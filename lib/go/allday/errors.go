@@ -0,0 +1,183 @@
+package allday
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors that failed transactions and scripts are classified into. They are
+// matched with errors.Is against the errors Submit and the read methods
+// return:
+//
+//	if errors.Is(err, allday.ErrEditionFull) {
+//		...
+//	}
+var (
+	// ErrMissingAdmin is returned when the signer has no AllDay Admin
+	// resource to mint or manage entities with
+	ErrMissingAdmin = errors.New("signer has no AllDay admin")
+	// ErrRecipientNotSetup is returned when a recipient has no AllDay
+	// collection
+	ErrRecipientNotSetup = errors.New("recipient has no AllDay collection")
+	// ErrOwnerNotSetup is returned when a sender has no AllDay collection
+	ErrOwnerNotSetup = errors.New("owner has no AllDay collection")
+
+	ErrSeriesNotFound  = errors.New("series not found")
+	ErrSeriesClosed    = errors.New("series is closed")
+	ErrDuplicateSeries = errors.New("series name already exists")
+
+	ErrSetNotFound  = errors.New("set not found")
+	ErrDuplicateSet = errors.New("set name already exists")
+
+	ErrPlayNotFound = errors.New("play not found")
+
+	ErrEditionNotFound = errors.New("edition not found")
+	// ErrEditionFull is returned when an edition has minted its max mint
+	// size. Closing an edition sets its max mint size to the number minted,
+	// so it is also returned for closed editions.
+	ErrEditionFull = errors.New("edition is full")
+	// ErrDuplicateEdition is returned when an edition with the same set,
+	// play, tier and parallel exists
+	ErrDuplicateEdition    = errors.New("edition already exists")
+	ErrDuplicateParallel   = errors.New("edition parallel already exists")
+	ErrInvalidTier         = errors.New("invalid tier")
+	ErrInvalidParallel     = errors.New("invalid parallel")
+	ErrInvalidMaxMintSize  = errors.New("invalid max mint size")
+	ErrArrayLengthMismatch = errors.New("argument arrays differ in length")
+	// ErrEditionIDMapNotMigrated is returned when looking up an edition
+	// created before the contract's edition ID map existed that has not been
+	// inserted into it with transactions/admin/editions/insert_edition_ids.cdc
+	ErrEditionIDMapNotMigrated = errors.New("edition ID map not migrated")
+
+	ErrMomentNotFound = errors.New("moment not found")
+
+	ErrBadgeNotFound      = errors.New("badge not found")
+	ErrDuplicateBadge     = errors.New("badge already exists")
+	ErrBadgeAlreadyAdded  = errors.New("badge already added to entity")
+	ErrInvalidEntityType  = errors.New("invalid badge entity type")
+	ErrPackNotFound       = errors.New("pack not found")
+	ErrInvalidPackStatus  = errors.New("invalid pack status")
+	ErrCommitHashMismatch = errors.New("pack commit hash mismatch")
+)
+
+// errorMessages maps the panic, precondition and assertion messages of the
+// contracts, transactions and scripts to the errors they are classified
+// into. They are matched in order, ignoring case.
+var errorMessages = []struct {
+	message string
+	err     error
+}{
+	{"Could not borrow a reference to the AllDay Admin capability", ErrMissingAdmin},
+	{"Could not borrow admin resource", ErrMissingAdmin},
+	{"Could not borrow a reference to the NFT minter", ErrMissingAdmin},
+	{"Could not borrow a reference to the recipient's collection", ErrRecipientNotSetup},
+	{"Could not borrow a reference to the collection receiver", ErrRecipientNotSetup},
+	{"Could not borrow a reference to the owner's collection", ErrOwnerNotSetup},
+	{"Could not borrow capability from public collection", ErrOwnerNotSetup},
+
+	{"series does not exist", ErrSeriesNotFound},
+	{"seriesID does not exist", ErrSeriesNotFound},
+	{"Cannot borrow series, no such", ErrSeriesNotFound},
+	{"cannot create an Edition with a closed Series", ErrSeriesClosed},
+	{"A Series with that name already exists", ErrDuplicateSeries},
+
+	{"set does not exist", ErrSetNotFound},
+	{"setID does not exist", ErrSetNotFound},
+	{"Cannot borrow set, no such", ErrSetNotFound},
+	{"A Set with that name already exists", ErrDuplicateSet},
+
+	{"play does not exist", ErrPlayNotFound},
+	{"playID does not exist", ErrPlayNotFound},
+	{"Cannot borrow play, no such", ErrPlayNotFound},
+
+	{"edition does not exist", ErrEditionNotFound},
+	{"Cannot borrow edition, no such", ErrEditionNotFound},
+	{"No such EditionID", ErrEditionNotFound},
+	{"max number of minted moments has been reached", ErrEditionFull},
+	{"max number of minted moments has already been reached", ErrEditionFull},
+	{"max edition size already reached", ErrEditionFull},
+	{"set play tier combination already exists in an edition", ErrDuplicateEdition},
+	{"edition ID map not migrated", ErrEditionIDMapNotMigrated},
+	{"parallel already exists for this edition", ErrDuplicateParallel},
+	{"tier is not a valid tier", ErrInvalidTier},
+	{"parallel is not a valid parallel", ErrInvalidParallel},
+	{"max mint size is zero", ErrInvalidMaxMintSize},
+	{"must pass arrays of same length", ErrArrayLengthMismatch},
+
+	{"missing NFT", ErrMomentNotFound},
+	{"NFT with provided ID must exist in the collection", ErrMomentNotFound},
+
+	{"badge doesn't exist", ErrBadgeNotFound},
+	{"Badge with specified slug does not exist", ErrBadgeNotFound},
+	{"badge already exists", ErrDuplicateBadge},
+	{"badge slug already added to", ErrBadgeAlreadyAdded},
+	{"Invalid entity type", ErrInvalidEntityType},
+
+	{"no such pack", ErrPackNotFound},
+	{"Pack status", ErrInvalidPackStatus},
+	{"Pack not revealed yet", ErrInvalidPackStatus},
+	{"CommitHash was not verified", ErrCommitHashMismatch},
+}
+
+// wholeErrorMessages are the messages too short to be matched within another
+// message. They are matched, before errorMessages, only by a failure whose
+// whole message they are.
+var wholeErrorMessages = []struct {
+	message string
+	err     error
+}{
+	{"not active", ErrSeriesClosed},
+}
+
+// Classify returns the error a contract or transaction failure message is
+// classified into, or nil if it is not a known failure
+func Classify(message string) error {
+	message = strings.ToLower(message)
+	for _, m := range wholeErrorMessages {
+		if containsWhole(message, strings.ToLower(m.message)) {
+			return m.err
+		}
+	}
+	for _, m := range errorMessages {
+		if strings.Contains(message, strings.ToLower(m.message)) {
+			return m.err
+		}
+	}
+	return nil
+}
+
+// containsWhole reports whether a line of message is failure, or ends with
+// failure after the "pre-condition failed: " or "panic: " kind of failure
+func containsWhole(message, failure string) bool {
+	for line := range strings.Lines(message) {
+		line = strings.TrimSpace(line)
+		if line == failure || strings.HasSuffix(line, ": "+failure) {
+			return true
+		}
+	}
+	return false
+}
+
+// classifiedError is a script error that also matches its classification
+type classifiedError struct {
+	err  error
+	kind error
+}
+
+func (e *classifiedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *classifiedError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+// classify wraps err so that it also matches the error its message is
+// classified into, if any
+func classify(err error) error {
+	kind := Classify(err.Error())
+	if kind == nil {
+		return err
+	}
+	return &classifiedError{err: err, kind: kind}
+}
@@ -0,0 +1,77 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		message string
+		want    error
+	}{
+		{"pre-condition failed: cannot create an Edition with a closed Series", ErrSeriesClosed},
+		{"pre-condition failed: not active", ErrSeriesClosed},
+		{"error: pre-condition failed: not active\n --> f8d6e0586b0a20c7.AllDay:165:16", ErrSeriesClosed},
+		{"pre-condition failed: listing not active", nil},
+		{"pre-condition failed: not active yet", nil},
+		{"pre-condition failed: max number of minted moments has been reached", ErrEditionFull},
+		{"pre-condition failed: max number of minted moments has already been reached", ErrEditionFull},
+		{"pre-condition failed: set play tier combination already exists in an edition", ErrDuplicateEdition},
+		{"pre-condition failed: tier is not a valid tier", ErrInvalidTier},
+		{"panic: Could not borrow a reference to the NFT minter", ErrMissingAdmin},
+		{"panic: Could not borrow a reference to the collection receiver", ErrRecipientNotSetup},
+		{"pre-condition failed: Cannot borrow Set, no such id", ErrSetNotFound},
+		{"pre-condition failed: no such editionID", ErrEditionNotFound},
+		{"assertion failed: badge slug already added to moment", ErrBadgeAlreadyAdded},
+		{"panic: Invalid entity type: team", ErrInvalidEntityType},
+		{"out of computation", nil},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, Classify(test.message), test.message)
+	}
+}
+
+// TestErrorMessagesExist checks that every classified message is still
+// raised by a contract, transaction or script
+func TestErrorMessagesExist(t *testing.T) {
+	var sources strings.Builder
+	for _, dir := range []string{"../../../contracts", "../../../transactions", "../../../scripts"} {
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || filepath.Ext(path) != ".cdc" {
+				return err
+			}
+			code, err := os.ReadFile(path)
+			sources.Write(code)
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	code := strings.ToLower(sources.String())
+	for _, m := range errorMessages {
+		assert.Contains(t, code, strings.ToLower(m.message))
+	}
+	for _, m := range wholeErrorMessages {
+		assert.Contains(t, code, `"`+strings.ToLower(m.message)+`"`)
+	}
+}
+
+func TestClassifiedScriptErrors(t *testing.T) {
+	failed := errors.New("pre-condition failed: Cannot borrow series, no such id")
+	client := NewClient(&fakeBackend{err: failed}, testEnv)
+
+	_, err := client.GetSeries(context.Background(), 9)
+	assert.ErrorIs(t, err, ErrSeriesNotFound)
+	assert.ErrorIs(t, err, failed)
+	assert.NotErrorIs(t, err, ErrSetNotFound)
+	assert.EqualError(t, err, "allday: get series 9: pre-condition failed: Cannot borrow series, no such id")
+}
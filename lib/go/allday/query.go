@@ -0,0 +1,176 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+// ------------------------------------------------------------
+// Editions
+// ------------------------------------------------------------
+
+// EditionFilter selects editions in ListEditions. Nil fields match every
+// edition.
+type EditionFilter struct {
+	SeriesID *uint64
+	SetID    *uint64
+	PlayID   *uint64
+	Tier     *model.Tier
+	// Parallel matches editions with the parallel. ParallelStandard matches
+	// editions created without one.
+	Parallel *model.Parallel
+	// Open matches editions that can still be minted in if true, and editions
+	// that are closed or have minted their max mint size if false
+	Open *bool
+	// MinRemainingSupply matches editions that can mint at least this many
+	// more moments, including editions without a max mint size
+	MinRemainingSupply *uint64
+}
+
+// Matches reports whether edition is selected by the filter
+func (f EditionFilter) Matches(edition model.Edition) bool {
+	switch {
+	case f.SeriesID != nil && edition.SeriesID != *f.SeriesID,
+		f.SetID != nil && edition.SetID != *f.SetID,
+		f.PlayID != nil && edition.PlayID != *f.PlayID,
+		f.Tier != nil && edition.Tier != *f.Tier,
+		f.Parallel != nil && edition.Parallel != *f.Parallel,
+		f.Open != nil && edition.MaxMintSizeReached() == *f.Open:
+		return false
+	}
+	if f.MinRemainingSupply != nil {
+		if remaining := edition.RemainingSupply(); remaining != nil && *remaining < *f.MinRemainingSupply {
+			return false
+		}
+	}
+	return true
+}
+
+// ListEditions returns the editions selected by filter, in ID order, with
+// their parallel. It reads the editions in pages of IDs, so it works for
+// any number of editions. Editions created while it runs are not returned.
+func (c *Client) ListEditions(ctx context.Context, filter EditionFilter) ([]model.Edition, error) {
+	next, err := c.NextEditionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var editions []model.Edition
+	for from := uint64(1); from < next; from += c.pageSize {
+		to := min(from+c.pageSize, next)
+		operation := fmt.Sprintf("get editions %d to %d", from, to-1)
+		page, err := execute(ctx, c, operation, c.builder.ReadEditionsByIDRange(from, to), model.DecodeEditions)
+		if err != nil {
+			return nil, err
+		}
+		for _, edition := range page {
+			if filter.Matches(edition) {
+				editions = append(editions, edition)
+			}
+		}
+	}
+	return editions, nil
+}
+
+// ------------------------------------------------------------
+// Moment NFTs
+// ------------------------------------------------------------
+
+// maxCollectionRestarts is how many times ForEachMomentID reads a collection
+// again after it changes
+const maxCollectionRestarts = 5
+
+// ErrCollectionChanged is returned by ForEachMomentID when the collection
+// keeps changing while it is read
+var ErrCollectionChanged = errors.New("collection changed while it was read")
+
+// idPage is the result of read_collection_nft_ids_page.cdc
+type idPage struct {
+	IDs    []uint64 `cadence:"ids"`
+	Length uint64   `cadence:"length"`
+}
+
+// ForEachMomentID calls fn with the ID of each moment in owner's collection,
+// reading the IDs in pages so it works for collections of any size. Each ID
+// is passed to fn once.
+//
+// Each page after the first starts one ID early, at the last ID of the page
+// before. If that ID moved, the collection changed in a way that could shift
+// IDs across the pages, so the IDs are read again from the first page,
+// skipping the IDs already passed to fn. Every moment held throughout is
+// visited, and moments deposited or withdrawn while it runs may or may not
+// be. If the collection changes more than maxCollectionRestarts times,
+// ErrCollectionChanged is returned.
+//
+// If fn returns an error, ForEachMomentID stops and returns it.
+func (c *Client) ForEachMomentID(ctx context.Context, owner flow.Address, fn func(id uint64) error) error {
+	operation := fmt.Sprintf("list moments of %s", owner.HexWithPrefix())
+	seen := map[uint64]bool{}
+	restarts := 0
+
+	var offset uint64
+	// last is the last ID of the page before, if there is one
+	var last *uint64
+	for {
+		from, limit := offset, c.pageSize
+		if last != nil {
+			from, limit = offset-1, limit+1
+		}
+		page, err := execute(ctx, c, operation, c.builder.ReadCollectionNFTIDsPage(owner, from, limit), decodeAs[idPage])
+		if err != nil {
+			return err
+		}
+		ids := page.IDs
+		if last != nil {
+			if len(ids) == 0 || ids[0] != *last {
+				if restarts == maxCollectionRestarts {
+					return fmt.Errorf("allday: %s: %w", operation, ErrCollectionChanged)
+				}
+				restarts++
+				offset, last = 0, nil
+				continue
+			}
+			ids = ids[1:]
+		}
+
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if err := fn(id); err != nil {
+				return err
+			}
+		}
+
+		offset += uint64(len(ids))
+		if offset >= page.Length || len(ids) == 0 {
+			return nil
+		}
+		last = &ids[len(ids)-1]
+	}
+}
+
+// GetMomentViews returns a view of each moment with ids in owner's
+// collection, in the order of ids, joined with its edition, play, series,
+// set and badges. IDs that are not in the collection are left out. Up to the
+// page size of IDs are read in each script, so a page of a wallet is read in
+// a single round trip.
+func (c *Client) GetMomentViews(ctx context.Context, owner flow.Address, ids []uint64) ([]model.MomentView, error) {
+	var views []model.MomentView
+	for from := 0; from < len(ids); from += int(c.pageSize) {
+		batch := ids[from:min(from+int(c.pageSize), len(ids))]
+		operation := fmt.Sprintf("get %d moments of %s", len(batch), owner.HexWithPrefix())
+		page, err := execute(ctx, c, operation, c.builder.ReadMomentViews(owner, batch), model.DecodeMomentViews)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, page...)
+	}
+	return views, nil
+}
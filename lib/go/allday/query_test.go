@@ -0,0 +1,243 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+func TestEditionFilter(t *testing.T) {
+	open := model.Edition{ID: 1, SeriesID: 1, SetID: 2, PlayID: 3, Tier: model.TierRare, MaxMintSize: uint64Ptr(10), NumMinted: 4, Parallel: model.ParallelStandard}
+	closed := model.Edition{ID: 2, SeriesID: 1, SetID: 2, PlayID: 3, Tier: model.TierRare, MaxMintSize: uint64Ptr(4), NumMinted: 4, Parallel: model.ParallelRuby}
+	unlimited := model.Edition{ID: 3, SeriesID: 2, SetID: 2, PlayID: 4, Tier: model.TierCommon, NumMinted: 100, Parallel: model.ParallelStandard}
+	yes, no := true, false
+	rare, standard := model.TierRare, model.ParallelStandard
+
+	tests := []struct {
+		name   string
+		filter EditionFilter
+		want   []model.Edition
+	}{
+		{"empty", EditionFilter{}, []model.Edition{open, closed, unlimited}},
+		{"series", EditionFilter{SeriesID: uint64Ptr(1)}, []model.Edition{open, closed}},
+		{"set and play", EditionFilter{SetID: uint64Ptr(2), PlayID: uint64Ptr(4)}, []model.Edition{unlimited}},
+		{"tier", EditionFilter{Tier: &rare}, []model.Edition{open, closed}},
+		{"standard parallel", EditionFilter{Parallel: &standard}, []model.Edition{open, unlimited}},
+		{"parallel", EditionFilter{Parallel: model.ParallelRuby.Optional()}, []model.Edition{closed}},
+		{"open", EditionFilter{Open: &yes}, []model.Edition{open, unlimited}},
+		{"closed", EditionFilter{Open: &no}, []model.Edition{closed}},
+		{"remaining supply", EditionFilter{MinRemainingSupply: uint64Ptr(6)}, []model.Edition{open, unlimited}},
+		{"no remaining supply", EditionFilter{MinRemainingSupply: uint64Ptr(7)}, []model.Edition{unlimited}},
+	}
+	for _, test := range tests {
+		var got []model.Edition
+		for _, edition := range []model.Edition{open, closed, unlimited} {
+			if test.filter.Matches(edition) {
+				got = append(got, edition)
+			}
+		}
+		assert.Equal(t, test.want, got, test.name)
+	}
+}
+
+func TestListEditions(t *testing.T) {
+	b := builders.New(testEnv)
+	var editions []model.Edition
+	for id := uint64(1); id <= 7; id++ {
+		tier := model.TierCommon
+		if id%2 == 0 {
+			tier = model.TierRare
+		}
+		editions = append(editions, model.Edition{ID: id, SeriesID: 1, SetID: 1, PlayID: id, Tier: tier, Parallel: model.ParallelStandard})
+	}
+	backend := &fakeBackend{execute: func(script builders.Script) (cadence.Value, error) {
+		if string(script.Code) == string(b.ReadNextEditionID().Code) {
+			return cadence.NewUInt64(8), nil
+		}
+		from, to := uint64(script.Arguments[0].(cadence.UInt64)), uint64(script.Arguments[1].(cadence.UInt64))
+		return codec.Encode(editions[from-1 : to-1])
+	}}
+	client := NewClient(backend, testEnv, WithPageSize(3))
+
+	rare := model.TierRare
+	got, err := client.ListEditions(context.Background(), EditionFilter{Tier: &rare})
+	require.NoError(t, err)
+	assert.Equal(t, []model.Edition{editions[1], editions[3], editions[5]}, got)
+
+	assert.Equal(t, []builders.Script{
+		b.ReadNextEditionID(),
+		b.ReadEditionsByIDRange(1, 4),
+		b.ReadEditionsByIDRange(4, 7),
+		b.ReadEditionsByIDRange(7, 8),
+	}, backend.scripts)
+}
+
+// collectionBackend answers read_collection_nft_ids_page.cdc from ids,
+// calling change before answering each page
+func collectionBackend(ids *[]uint64, change func(page int)) *fakeBackend {
+	page := 0
+	return &fakeBackend{execute: func(script builders.Script) (cadence.Value, error) {
+		change(page)
+		page++
+		offset, limit := uint64(script.Arguments[1].(cadence.UInt64)), uint64(script.Arguments[2].(cadence.UInt64))
+		length := uint64(len(*ids))
+		from := min(offset, length)
+		upTo := min(from+limit, length)
+		return codec.Encode(idPage{IDs: (*ids)[from:upTo], Length: length})
+	}}
+}
+
+func TestForEachMomentID(t *testing.T) {
+	owner := flow.HexToAddress("01")
+	ctx := context.Background()
+
+	t.Run("Should visit every ID across pages", func(t *testing.T) {
+		ids := []uint64{5, 3, 9, 1, 7, 2, 8}
+		backend := collectionBackend(&ids, func(int) {})
+		client := NewClient(backend, testEnv, WithPageSize(3))
+
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, owner, func(id uint64) error {
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ids, visited)
+
+		b := builders.New(testEnv)
+		assert.Equal(t, []builders.Script{
+			b.ReadCollectionNFTIDsPage(owner, 0, 3),
+			b.ReadCollectionNFTIDsPage(owner, 2, 4),
+			b.ReadCollectionNFTIDsPage(owner, 5, 4),
+		}, backend.scripts)
+	})
+
+	t.Run("Should visit every held ID once if the collection changes", func(t *testing.T) {
+		ids := []uint64{5, 3, 9, 1, 7, 2, 8}
+		backend := collectionBackend(&ids, func(page int) {
+			if page == 1 {
+				// withdrawing 3 shifts 1 onto the first page
+				ids = []uint64{5, 9, 1, 7, 2, 8}
+			}
+		})
+		client := NewClient(backend, testEnv, WithPageSize(3))
+
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, owner, func(id uint64) error {
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{5, 3, 9, 1, 7, 2, 8}, visited)
+		assert.Len(t, backend.scripts, 4)
+	})
+
+	t.Run("Should visit every held ID if a withdrawal and a deposit keep the length", func(t *testing.T) {
+		ids := []uint64{5, 3, 9, 1, 7, 2, 8}
+		backend := collectionBackend(&ids, func(page int) {
+			if page == 1 {
+				// withdrawing 3 shifts 1 onto the first page, and depositing
+				// 4 keeps the length
+				ids = []uint64{5, 9, 1, 7, 2, 8, 4}
+			}
+		})
+		client := NewClient(backend, testEnv, WithPageSize(3))
+
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, owner, func(id uint64) error {
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{5, 3, 9, 1, 7, 2, 8, 4}, visited)
+		assert.Len(t, backend.scripts, 5)
+	})
+
+	t.Run("Should return ErrCollectionChanged if the collection keeps changing", func(t *testing.T) {
+		ids := []uint64{1, 2, 3}
+		backend := collectionBackend(&ids, func(page int) {
+			// each deposit shifts the IDs read so far
+			ids = append([]uint64{uint64(page) + 10}, ids...)
+		})
+		client := NewClient(backend, testEnv, WithPageSize(2))
+
+		err := client.ForEachMomentID(ctx, owner, func(uint64) error { return nil })
+		assert.ErrorIs(t, err, ErrCollectionChanged)
+		assert.Len(t, backend.scripts, 2*maxCollectionRestarts+2)
+	})
+
+	t.Run("Should stop at the first error fn returns", func(t *testing.T) {
+		ids := []uint64{1, 2, 3, 4}
+		backend := collectionBackend(&ids, func(int) {})
+		client := NewClient(backend, testEnv, WithPageSize(2))
+		stop := errors.New("stop")
+
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, owner, func(id uint64) error {
+			visited = append(visited, id)
+			if id == 1 {
+				return stop
+			}
+			return nil
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, []uint64{1}, visited)
+		assert.Len(t, backend.scripts, 1)
+	})
+
+	t.Run("Should return ErrOwnerNotSetup if owner has no collection", func(t *testing.T) {
+		failed := errors.New("panic: Could not borrow capability from public collection")
+		client := NewClient(&fakeBackend{err: failed}, testEnv)
+
+		err := client.ForEachMomentID(ctx, owner, func(uint64) error { return nil })
+		assert.ErrorIs(t, err, ErrOwnerNotSetup)
+	})
+}
+
+func TestGetMomentViews(t *testing.T) {
+	owner := flow.HexToAddress("01")
+	held := map[uint64]model.MomentView{}
+	for _, id := range []uint64{1, 2, 4, 5} {
+		held[id] = model.MomentView{
+			ID:           id,
+			SerialNumber: id,
+			MintingDate:  time.Unix(1700000000, 0).UTC(),
+			Edition:      model.Edition{ID: 1, SeriesID: 1, SetID: 1, PlayID: 1, Tier: model.TierCommon, NumMinted: 5, Parallel: model.ParallelStandard},
+			Play:         model.Play{ID: 1, Classification: "PLAYER_GAME", Metadata: map[string]string{"playType": "Pass"}},
+			SeriesName:   "Series One",
+			SetName:      "Set One",
+			Badges:       []model.Badge{{Slug: "rookie", Metadata: map[string]string{}}},
+		}
+	}
+	backend := &fakeBackend{execute: func(script builders.Script) (cadence.Value, error) {
+		views := []model.MomentView{}
+		for _, id := range script.Arguments[1].(cadence.Array).Values {
+			if view, ok := held[uint64(id.(cadence.UInt64))]; ok {
+				views = append(views, view)
+			}
+		}
+		return codec.Encode(views)
+	}}
+	client := NewClient(backend, testEnv, WithPageSize(2))
+
+	views, err := client.GetMomentViews(context.Background(), owner, []uint64{5, 3, 1, 2, 4})
+	require.NoError(t, err)
+	assert.Equal(t, []model.MomentView{held[5], held[1], held[2], held[4]}, views)
+
+	b := builders.New(testEnv)
+	assert.Equal(t, []builders.Script{
+		b.ReadMomentViews(owner, []uint64{5, 3}),
+		b.ReadMomentViews(owner, []uint64{1, 2}),
+		b.ReadMomentViews(owner, []uint64{4}),
+	}, backend.scripts)
+}
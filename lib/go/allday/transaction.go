@@ -0,0 +1,136 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+)
+
+// ErrTransactionExpired is returned by Submit when a transaction expired
+// before it was included in a block
+var ErrTransactionExpired = errors.New("transaction expired")
+
+// TransactionError is the error of a transaction that was sealed but failed
+// to execute, such as by failing a precondition. It matches its Kind with
+// errors.Is.
+type TransactionError struct {
+	// ID is the failed transaction's ID
+	ID flow.Identifier
+	// Message is the execution error reported by the chain
+	Message string
+	// Kind is the error Message is classified into, such as ErrSeriesClosed,
+	// or nil if it is not a known failure
+	Kind error
+	// Err is the error reported by the backend
+	Err error
+}
+
+func (e *TransactionError) Error() string {
+	return fmt.Sprintf("allday: transaction %s failed: %s", e.ID, e.Message)
+}
+
+func (e *TransactionError) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// TxResult is the final result of a submitted transaction
+type TxResult struct {
+	ID              flow.Identifier
+	Status          flow.TransactionStatus
+	BlockID         flow.Identifier
+	BlockHeight     uint64
+	ComputationUsed uint64
+	// Events are the AllDay and PackNFT events the transaction emitted, in
+	// order. Events of other contracts are left out.
+	Events []events.Event
+	// Err is a *TransactionError if the transaction failed, an error
+	// wrapping ErrTransactionExpired if it expired, and nil otherwise
+	Err error
+}
+
+// Succeeded reports whether the transaction was sealed without an error
+func (r *TxResult) Succeeded() bool {
+	return r.Status == flow.TransactionStatusSealed && r.Err == nil
+}
+
+// Submit sends a signed transaction and waits until it is sealed or expired,
+// polling its result with exponential backoff.
+//
+// The result is returned whenever the transaction reached a final status.
+// If the transaction failed or expired, the returned error is result.Err.
+// Otherwise a nil result means the transaction could not be sent or its
+// result could not be read, such as when ctx is done first.
+func (c *Client) Submit(ctx context.Context, tx *flow.Transaction) (*TxResult, error) {
+	id := tx.ID()
+	if err := c.backend.SendTransaction(ctx, *tx); err != nil {
+		return nil, fmt.Errorf("allday: send transaction %s: %w", id, err)
+	}
+
+	result, err := c.wait(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result, result.Err
+}
+
+// wait polls the result of the transaction with id until it is final
+func (c *Client) wait(ctx context.Context, id flow.Identifier) (*TxResult, error) {
+	interval := c.pollInterval
+	for {
+		result, err := c.backend.GetTransactionResult(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("allday: get transaction result %s: %w", id, err)
+		}
+		if result.Status == flow.TransactionStatusSealed || result.Status == flow.TransactionStatusExpired {
+			return c.txResult(id, result)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("allday: wait for transaction %s: %w", id, ctx.Err())
+		case <-timer.C:
+		}
+		interval = min(2*interval, c.maxPollInterval)
+	}
+}
+
+// txResult converts a final transaction result, decoding its events
+func (c *Client) txResult(id flow.Identifier, result *flow.TransactionResult) (*TxResult, error) {
+	txResult := &TxResult{
+		ID:              id,
+		Status:          result.Status,
+		BlockID:         result.BlockID,
+		BlockHeight:     result.BlockHeight,
+		ComputationUsed: result.ComputationUsage,
+	}
+
+	for _, flowEvent := range result.Events {
+		event, err := c.decoder.Decode(flowEvent)
+		if errors.Is(err, events.ErrUnknownEvent) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("allday: decode events of transaction %s: %w", id, err)
+		}
+		txResult.Events = append(txResult.Events, event)
+	}
+
+	switch {
+	case result.Status == flow.TransactionStatusExpired:
+		txResult.Err = fmt.Errorf("allday: transaction %s: %w", id, ErrTransactionExpired)
+	case result.Error != nil:
+		message := result.Error.Error()
+		txResult.Err = &TransactionError{ID: id, Message: message, Kind: Classify(message), Err: result.Error}
+	}
+	return txResult, nil
+}
@@ -0,0 +1,131 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+)
+
+func seriesCreatedEvent(address string) flow.Event {
+	location := common.NewAddressLocation(nil, common.Address(flow.HexToAddress(address)), "AllDay")
+	value := cadence.NewEvent([]cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.String("Series One"),
+	}).WithType(cadence.NewEventType(location, "AllDay.SeriesCreated", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "name", Type: cadence.StringType},
+	}, nil))
+	return flow.Event{Type: value.EventType.ID(), Value: value}
+}
+
+func newTestTransaction() *flow.Transaction {
+	return flow.NewTransaction().
+		SetScript([]byte("transaction {}")).
+		SetReferenceBlockID(flow.Identifier{1})
+}
+
+func TestSubmit(t *testing.T) {
+	tx := newTestTransaction()
+	backend := &fakeBackend{txResults: []*flow.TransactionResult{
+		{Status: flow.TransactionStatusPending},
+		{Status: flow.TransactionStatusExecuted},
+		{
+			Status:           flow.TransactionStatusSealed,
+			BlockID:          flow.Identifier{2},
+			BlockHeight:      7,
+			ComputationUsage: 12,
+			Events: []flow.Event{
+				seriesCreatedEvent("e4cf4bdc1751c65d"),
+				// emitted by another deployment, so left out
+				seriesCreatedEvent("4dfd62c88d1b6462"),
+			},
+		},
+	}}
+	client := NewClient(backend, testEnv, WithPollInterval(time.Millisecond, 2*time.Millisecond))
+
+	result, err := client.Submit(context.Background(), tx)
+	require.NoError(t, err)
+	assert.Equal(t, &TxResult{
+		ID:              tx.ID(),
+		Status:          flow.TransactionStatusSealed,
+		BlockID:         flow.Identifier{2},
+		BlockHeight:     7,
+		ComputationUsed: 12,
+		Events:          []events.Event{events.SeriesCreated{ID: 1, Name: "Series One"}},
+	}, result)
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, 3, backend.polls)
+	require.Len(t, backend.transactions, 1)
+	assert.Equal(t, tx.ID(), backend.transactions[0].ID())
+}
+
+func TestSubmitFailures(t *testing.T) {
+	t.Run("Should return a transaction error if execution failed", func(t *testing.T) {
+		tx := newTestTransaction()
+		failed := errors.New("pre-condition failed: cannot create an Edition with a closed Series")
+		backend := &fakeBackend{txResults: []*flow.TransactionResult{
+			{Status: flow.TransactionStatusSealed, BlockHeight: 3, Error: failed},
+		}}
+		client := NewClient(backend, testEnv)
+
+		result, err := client.Submit(context.Background(), tx)
+		require.NotNil(t, result)
+		assert.Equal(t, result.Err, err)
+		assert.False(t, result.Succeeded())
+		assert.ErrorIs(t, err, failed)
+		assert.ErrorIs(t, err, ErrSeriesClosed)
+		assert.NotErrorIs(t, err, ErrEditionFull)
+
+		var txErr *TransactionError
+		require.ErrorAs(t, err, &txErr)
+		assert.Equal(t, tx.ID(), txErr.ID)
+		assert.Equal(t, failed.Error(), txErr.Message)
+		assert.Equal(t, ErrSeriesClosed, txErr.Kind)
+	})
+
+	t.Run("Should return ErrTransactionExpired if the transaction expired", func(t *testing.T) {
+		backend := &fakeBackend{txResults: []*flow.TransactionResult{
+			{Status: flow.TransactionStatusExpired},
+		}}
+		client := NewClient(backend, testEnv)
+
+		result, err := client.Submit(context.Background(), newTestTransaction())
+		require.NotNil(t, result)
+		assert.Equal(t, flow.TransactionStatusExpired, result.Status)
+		assert.ErrorIs(t, err, ErrTransactionExpired)
+	})
+
+	t.Run("Should return the send error if the transaction was rejected", func(t *testing.T) {
+		rejected := errors.New("invalid signature")
+		backend := &fakeBackend{err: rejected}
+		client := NewClient(backend, testEnv)
+
+		result, err := client.Submit(context.Background(), newTestTransaction())
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, rejected)
+		assert.Zero(t, backend.polls)
+	})
+
+	t.Run("Should stop polling when the context is done", func(t *testing.T) {
+		backend := &fakeBackend{txResults: []*flow.TransactionResult{
+			{Status: flow.TransactionStatusPending},
+		}}
+		client := NewClient(backend, testEnv, WithPollInterval(time.Millisecond, time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		result, err := client.Submit(ctx, newTestTransaction())
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Greater(t, backend.polls, 1)
+	})
+}
@@ -0,0 +1,302 @@
+package allday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+// Violation is a check a proposed operation fails, which would make its
+// transaction revert
+type Violation struct {
+	// Err is the error the transaction's failure would be classified
+	// into, such as ErrSeriesClosed
+	Err error
+	// Message describes the violation
+	Message string
+}
+
+func (v Violation) Error() string {
+	return v.Message
+}
+
+func (v Violation) Unwrap() error {
+	return v.Err
+}
+
+// ValidationError lists every violation of a proposed operation. It matches
+// the Err of each violation with errors.Is.
+type ValidationError struct {
+	Operation  string
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Message
+	}
+	return fmt.Sprintf("allday: %s: %s", e.Operation, strings.Join(messages, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, violation := range e.Violations {
+		errs[i] = violation
+	}
+	return errs
+}
+
+// badgeEntityTypes are the entity types the badge transactions accept
+var badgeEntityTypes = map[string]bool{"play": true, "edition": true, "moment": true, "set": true, "series": true}
+
+// validation collects the violations of an operation
+type validation struct {
+	operation  string
+	violations []Violation
+}
+
+func (v *validation) add(err error, format string, args ...any) {
+	v.violations = append(v.violations, Violation{Err: err, Message: fmt.Sprintf(format, args...)})
+}
+
+// check adds a violation if err is classified into notFound, and returns
+// whether the entity was found. Other errors are returned, as the state
+// could not be read.
+func (v *validation) check(err error, notFound error, format string, args ...any) (bool, error) {
+	if errors.Is(err, notFound) {
+		v.add(notFound, format, args...)
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// result returns a *ValidationError listing the violations, or nil if there
+// are none
+func (v *validation) result() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Operation: v.operation, Violations: v.violations}
+}
+
+// ------------------------------------------------------------
+// Editions
+// ------------------------------------------------------------
+
+// ValidateCreateEdition checks the preconditions of AllDay.Edition's
+// initializer against the current state, taking the arguments of
+// create_edition.cdc. It returns a *ValidationError listing every violation,
+// or another error if the state could not be read.
+func (c *Client) ValidateCreateEdition(ctx context.Context, seriesID, setID, playID uint64, tier model.Tier, parallel *model.Parallel, maxMintSize *uint64) error {
+	v := &validation{operation: "create edition"}
+
+	if maxMintSize != nil && *maxMintSize == 0 {
+		v.add(ErrInvalidMaxMintSize, "max mint size is zero, must either be nil or greater than 0")
+	}
+
+	series, err := c.GetSeries(ctx, seriesID)
+	found, err := v.check(err, ErrSeriesNotFound, "series %d does not exist", seriesID)
+	if err != nil {
+		return err
+	}
+	if found && !series.Active {
+		v.add(ErrSeriesClosed, "series %d is closed", seriesID)
+	}
+
+	_, err = c.GetSet(ctx, setID)
+	if _, err := v.check(err, ErrSetNotFound, "set %d does not exist", setID); err != nil {
+		return err
+	}
+	_, err = c.GetPlay(ctx, playID)
+	if _, err := v.check(err, ErrPlayNotFound, "play %d does not exist", playID); err != nil {
+		return err
+	}
+
+	if !tier.IsValid() {
+		v.add(ErrInvalidTier, "tier %q is not a valid tier", tier)
+	}
+	if parallel != nil && (!parallel.IsValid() || parallel.IsStandard()) {
+		v.add(ErrInvalidParallel, "parallel %q is not a valid parallel", *parallel)
+	}
+
+	existing, err := c.FindEditionID(ctx, setID, playID, tier, parallel)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		want := model.ParallelStandard
+		if parallel != nil {
+			want = *parallel
+		}
+		v.add(ErrDuplicateEdition, "edition %d already has set %d, play %d, tier %s and parallel %s", *existing, setID, playID, tier, want)
+	}
+
+	return v.result()
+}
+
+// ValidateCloseEdition checks that the edition with id exists and can still
+// be closed
+func (c *Client) ValidateCloseEdition(ctx context.Context, id uint64) error {
+	v := &validation{operation: fmt.Sprintf("close edition %d", id)}
+
+	edition, err := c.GetEdition(ctx, id)
+	found, err := v.check(err, ErrEditionNotFound, "edition %d does not exist", id)
+	if err != nil {
+		return err
+	}
+	if found && edition.MaxMintSizeReached() {
+		v.add(ErrEditionFull, "edition %d is already closed or has minted its max mint size", id)
+	}
+
+	return v.result()
+}
+
+// ------------------------------------------------------------
+// Moment NFTs
+// ------------------------------------------------------------
+
+// ValidateMintMomentNFT checks that a moment can be minted in the edition
+// with editionID and deposited to recipient
+func (c *Client) ValidateMintMomentNFT(ctx context.Context, recipient flow.Address, editionID uint64) error {
+	v := &validation{operation: fmt.Sprintf("mint moment in edition %d", editionID)}
+
+	edition, err := c.GetEdition(ctx, editionID)
+	found, err := v.check(err, ErrEditionNotFound, "edition %d does not exist", editionID)
+	if err != nil {
+		return err
+	}
+	if found && edition.MaxMintSizeReached() {
+		v.add(ErrEditionFull, "edition %d is closed or has minted its max mint size of %d", editionID, *edition.MaxMintSize)
+	}
+
+	setup, err := c.IsAccountSetup(ctx, recipient)
+	if err != nil {
+		return err
+	}
+	if !setup {
+		v.add(ErrRecipientNotSetup, "recipient %s has no AllDay collection", recipient.HexWithPrefix())
+	}
+
+	return v.result()
+}
+
+// ------------------------------------------------------------
+// Badges
+// ------------------------------------------------------------
+
+// ValidateCreateBadge checks that no badge has slug
+func (c *Client) ValidateCreateBadge(ctx context.Context, slug string) error {
+	v := &validation{operation: fmt.Sprintf("create badge %q", slug)}
+
+	badge, err := c.GetBadge(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if badge != nil {
+		v.add(ErrDuplicateBadge, "badge %q already exists", slug)
+	}
+
+	return v.result()
+}
+
+// ValidateUpdateBadge checks that the badge with slug exists
+func (c *Client) ValidateUpdateBadge(ctx context.Context, slug string) error {
+	v := &validation{operation: fmt.Sprintf("update badge %q", slug)}
+	if err := c.checkBadgeExists(ctx, v, slug); err != nil {
+		return err
+	}
+	return v.result()
+}
+
+// ValidateDeleteBadge checks that the badge with slug exists
+func (c *Client) ValidateDeleteBadge(ctx context.Context, slug string) error {
+	v := &validation{operation: fmt.Sprintf("delete badge %q", slug)}
+	if err := c.checkBadgeExists(ctx, v, slug); err != nil {
+		return err
+	}
+	return v.result()
+}
+
+// ValidateAddBadgeToEntity checks that the badge with slug exists, that
+// entityType names an entity that exists, and that the entity does not
+// already have the badge. The contract does not check the entity exists, so
+// a badge added to a missing entity would be stored unused.
+func (c *Client) ValidateAddBadgeToEntity(ctx context.Context, slug string, entityType string, entityID uint64) error {
+	v := &validation{operation: fmt.Sprintf("add badge %q to %s %d", slug, entityType, entityID)}
+	if err := c.checkBadgeExists(ctx, v, slug); err != nil {
+		return err
+	}
+	if err := c.checkEntityExists(ctx, v, entityType, entityID); err != nil {
+		return err
+	}
+	if !badgeEntityTypes[entityType] {
+		return v.result()
+	}
+	slugs, err := c.GetEntityBadgeSlugs(ctx, entityType, entityID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(slugs, slug) {
+		v.add(ErrBadgeAlreadyAdded, "%s %d already has badge %q", entityType, entityID, slug)
+	}
+	return v.result()
+}
+
+// ValidateRemoveBadgeFromEntity checks that entityType is valid. Removing a
+// badge an entity does not have succeeds without changes.
+func (c *Client) ValidateRemoveBadgeFromEntity(ctx context.Context, slug string, entityType string, entityID uint64) error {
+	v := &validation{operation: fmt.Sprintf("remove badge %q from %s %d", slug, entityType, entityID)}
+	if !badgeEntityTypes[entityType] {
+		v.add(ErrInvalidEntityType, "invalid entity type %q", entityType)
+	}
+	return v.result()
+}
+
+// checkBadgeExists adds a violation if no badge has slug
+func (c *Client) checkBadgeExists(ctx context.Context, v *validation, slug string) error {
+	badge, err := c.GetBadge(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if badge == nil {
+		v.add(ErrBadgeNotFound, "badge %q does not exist", slug)
+	}
+	return nil
+}
+
+// checkEntityExists adds a violation if entityType is not valid or the
+// entity does not exist. Moments can be held in any account, so a moment
+// is only checked to have been minted.
+func (c *Client) checkEntityExists(ctx context.Context, v *validation, entityType string, entityID uint64) error {
+	var err error
+	switch entityType {
+	case "play":
+		_, err = c.GetPlay(ctx, entityID)
+		_, err = v.check(err, ErrPlayNotFound, "play %d does not exist", entityID)
+	case "edition":
+		_, err = c.GetEdition(ctx, entityID)
+		_, err = v.check(err, ErrEditionNotFound, "edition %d does not exist", entityID)
+	case "set":
+		_, err = c.GetSet(ctx, entityID)
+		_, err = v.check(err, ErrSetNotFound, "set %d does not exist", entityID)
+	case "series":
+		_, err = c.GetSeries(ctx, entityID)
+		_, err = v.check(err, ErrSeriesNotFound, "series %d does not exist", entityID)
+	case "moment":
+		var supply uint64
+		supply, err = c.TotalSupply(ctx)
+		if err == nil && (entityID == 0 || entityID > supply) {
+			v.add(ErrMomentNotFound, "moment %d has not been minted", entityID)
+		}
+	default:
+		v.add(ErrInvalidEntityType, "invalid entity type %q", entityType)
+	}
+	return err
+}
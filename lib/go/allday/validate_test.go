@@ -0,0 +1,217 @@
+package allday
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+// chainState answers the scripts the validator runs from a fixed state
+type chainState struct {
+	series   map[uint64]model.Series
+	sets     map[uint64]model.Set
+	plays    map[uint64]model.Play
+	editions []model.Edition
+	setup    map[flow.Address]bool
+	badges   map[string]model.Badge
+	// entityBadges are the badge slugs attached to each entity, by entity
+	// type and ID
+	entityBadges map[string]map[uint64][]string
+	supply       uint64
+}
+
+func (s chainState) backend(t *testing.T) *fakeBackend {
+	b := builders.New(testEnv)
+	scripts := map[string]func() (any, error){
+		key(b.ReadAllEditions()):     func() (any, error) { return s.editions, nil },
+		key(b.ReadMomentNFTSupply()): func() (any, error) { return s.supply, nil },
+	}
+	for id := uint64(0); id < 10; id++ {
+		scripts[key(b.ReadSeriesByID(id))] = lookup(s.series, id, "Cannot borrow series, no such id")
+		scripts[key(b.ReadSetByID(id))] = lookup(s.sets, id, "Cannot borrow Set, no such id")
+		scripts[key(b.ReadPlayByID(id))] = lookup(s.plays, id, "Cannot borrow Play, no such id")
+		editions := map[uint64]model.Edition{}
+		for _, edition := range s.editions {
+			editions[edition.ID] = edition
+		}
+		scripts[key(b.ReadEditionByID(id))] = lookup(editions, id, "Cannot borrow edition, no such id")
+	}
+	for _, edition := range s.editions {
+		id := edition.ID
+		find := b.ReadEditionIDBySetPlayTierParallel(edition.SetID, edition.PlayID, edition.Tier, edition.Parallel.Optional())
+		scripts[key(find)] = func() (any, error) { return &id, nil }
+	}
+	for address, setup := range s.setup {
+		scripts[key(b.AccountIsSetup(address))] = func() (any, error) { return setup, nil }
+	}
+	for _, slug := range []string{"rookie", "missing"} {
+		badge, ok := s.badges[slug]
+		scripts[key(b.GetBadgeBySlug(slug))] = func() (any, error) {
+			if !ok {
+				return (*model.Badge)(nil), nil
+			}
+			return &badge, nil
+		}
+	}
+
+	for entityType := range badgeEntityTypes {
+		for id := uint64(0); id < 10; id++ {
+			slugs := s.entityBadges[entityType][id]
+			scripts[key(b.GetEntityBadgeSlugs(entityType, id))] = func() (any, error) { return append([]string{}, slugs...), nil }
+		}
+	}
+
+	return &fakeBackend{execute: func(script builders.Script) (cadence.Value, error) {
+		result, ok := scripts[key(script)]
+		if !ok && bytes.Equal(script.Code, b.ReadEditionIDBySetPlayTierParallel(0, 0, "", nil).Code) {
+			// no edition has the set, play, tier and parallel
+			result, ok = func() (any, error) { return (*uint64)(nil), nil }, true
+		}
+		require.True(t, ok, "unexpected script %s", script.Code)
+		value, err := result()
+		if err != nil {
+			return nil, err
+		}
+		return codec.Encode(value)
+	}}
+}
+
+func key(script builders.Script) string {
+	return fmt.Sprint(string(script.Code), script.Arguments)
+}
+
+func lookup[T any](values map[uint64]T, id uint64, notFound string) func() (any, error) {
+	return func() (any, error) {
+		value, ok := values[id]
+		if !ok {
+			return nil, errors.New("pre-condition failed: " + notFound)
+		}
+		return value, nil
+	}
+}
+
+func uint64Ptr(i uint64) *uint64 {
+	return &i
+}
+
+func testState() chainState {
+	return chainState{
+		series: map[uint64]model.Series{
+			1: {ID: 1, Name: "Series One", Active: true},
+			2: {ID: 2, Name: "Series Two", Active: false},
+		},
+		sets:  map[uint64]model.Set{1: {ID: 1, Name: "Set One"}},
+		plays: map[uint64]model.Play{1: {ID: 1, Classification: "PLAYER_GAME"}},
+		editions: []model.Edition{
+			{ID: 1, SeriesID: 1, SetID: 1, PlayID: 1, Tier: model.TierCommon, MaxMintSize: uint64Ptr(2), NumMinted: 2, Parallel: model.ParallelStandard},
+			{ID: 2, SeriesID: 1, SetID: 1, PlayID: 1, Tier: model.TierCommon, NumMinted: 1, Parallel: model.ParallelRuby},
+		},
+		setup:        map[flow.Address]bool{flow.HexToAddress("01"): true, flow.HexToAddress("02"): false},
+		badges:       map[string]model.Badge{"rookie": {Slug: "rookie"}},
+		entityBadges: map[string]map[uint64][]string{"play": {1: {"mvp", "rookie"}}},
+		supply:       3,
+	}
+}
+
+func TestValidateCreateEdition(t *testing.T) {
+	client := NewClient(testState().backend(t), testEnv)
+	ctx := context.Background()
+
+	t.Run("Should accept a valid edition", func(t *testing.T) {
+		err := client.ValidateCreateEdition(ctx, 1, 1, 1, model.TierRare, model.ParallelRuby.Optional(), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should report every violation at once", func(t *testing.T) {
+		parallel := model.ParallelStandard
+		err := client.ValidateCreateEdition(ctx, 2, 5, 1, "EPIC", &parallel, uint64Ptr(0))
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.EqualError(t, err, `allday: create edition: max mint size is zero, must either be nil or greater than 0; `+
+			`series 2 is closed; set 5 does not exist; tier "EPIC" is not a valid tier; parallel "Standard" is not a valid parallel`)
+		for _, kind := range []error{ErrInvalidMaxMintSize, ErrSeriesClosed, ErrSetNotFound, ErrInvalidTier, ErrInvalidParallel} {
+			assert.ErrorIs(t, err, kind)
+		}
+		assert.NotErrorIs(t, err, ErrPlayNotFound)
+	})
+
+	t.Run("Should report a duplicate set, play, tier and parallel", func(t *testing.T) {
+		err := client.ValidateCreateEdition(ctx, 1, 1, 1, model.TierCommon, nil, nil)
+		assert.ErrorIs(t, err, ErrDuplicateEdition)
+		assert.EqualError(t, err, "allday: create edition: edition 1 already has set 1, play 1, tier COMMON and parallel Standard")
+
+		err = client.ValidateCreateEdition(ctx, 1, 1, 1, model.TierCommon, model.ParallelRuby.Optional(), nil)
+		assert.ErrorIs(t, err, ErrDuplicateEdition)
+
+		err = client.ValidateCreateEdition(ctx, 9, 1, 1, model.TierCommon, model.ParallelEmerald.Optional(), nil)
+		assert.ErrorIs(t, err, ErrSeriesNotFound)
+		assert.NotErrorIs(t, err, ErrDuplicateEdition)
+	})
+}
+
+func TestValidateEditionOperations(t *testing.T) {
+	client := NewClient(testState().backend(t), testEnv)
+	ctx := context.Background()
+
+	assert.NoError(t, client.ValidateCloseEdition(ctx, 2))
+	assert.ErrorIs(t, client.ValidateCloseEdition(ctx, 1), ErrEditionFull)
+	assert.ErrorIs(t, client.ValidateCloseEdition(ctx, 7), ErrEditionNotFound)
+
+	assert.NoError(t, client.ValidateMintMomentNFT(ctx, flow.HexToAddress("01"), 2))
+	err := client.ValidateMintMomentNFT(ctx, flow.HexToAddress("02"), 1)
+	assert.ErrorIs(t, err, ErrEditionFull)
+	assert.ErrorIs(t, err, ErrRecipientNotSetup)
+	assert.EqualError(t, err, "allday: mint moment in edition 1: edition 1 is closed or has minted its max mint size of 2; "+
+		"recipient 0x0000000000000002 has no AllDay collection")
+}
+
+func TestValidateBadgeOperations(t *testing.T) {
+	client := NewClient(testState().backend(t), testEnv)
+	ctx := context.Background()
+
+	assert.NoError(t, client.ValidateCreateBadge(ctx, "missing"))
+	assert.ErrorIs(t, client.ValidateCreateBadge(ctx, "rookie"), ErrDuplicateBadge)
+	assert.NoError(t, client.ValidateUpdateBadge(ctx, "rookie"))
+	assert.ErrorIs(t, client.ValidateDeleteBadge(ctx, "missing"), ErrBadgeNotFound)
+
+	assert.NoError(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "edition", 1))
+	err := client.ValidateAddBadgeToEntity(ctx, "rookie", "play", 1)
+	assert.ErrorIs(t, err, ErrBadgeAlreadyAdded)
+	assert.EqualError(t, err, `allday: add badge "rookie" to play 1: play 1 already has badge "rookie"`)
+	assert.NoError(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "moment", 3))
+	assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "edition", 4), ErrEditionNotFound)
+	assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "moment", 4), ErrMomentNotFound)
+	assert.NoError(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "set", 1))
+	assert.NoError(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "series", 2))
+	assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "set", 2), ErrSetNotFound)
+	assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "series", 3), ErrSeriesNotFound)
+	assert.NoError(t, client.ValidateRemoveBadgeFromEntity(ctx, "rookie", "series", 1))
+
+	err = client.ValidateAddBadgeToEntity(ctx, "missing", "team", 1)
+	assert.ErrorIs(t, err, ErrBadgeNotFound)
+	assert.ErrorIs(t, err, ErrInvalidEntityType)
+
+	assert.ErrorIs(t, client.ValidateRemoveBadgeFromEntity(ctx, "rookie", "team", 1), ErrInvalidEntityType)
+}
+
+func TestValidateReadErrors(t *testing.T) {
+	unavailable := errors.New("access node unavailable")
+	client := NewClient(&fakeBackend{err: unavailable}, testEnv)
+
+	err := client.ValidateCreateEdition(context.Background(), 1, 1, 1, model.TierCommon, nil, nil)
+	assert.ErrorIs(t, err, unavailable)
+	var validationErr *ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+}
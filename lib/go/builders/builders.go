@@ -0,0 +1,141 @@
+// Package builders builds the AllDay transactions and scripts with typed
+// arguments, encoded in the order their Cadence parameter lists declare them.
+//
+// The builders in transaction_builders.go and script_builders.go are
+// generated from the .cdc files, run go generate from the repository root
+// after changing a parameter list.
+package builders
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+)
+
+// DefaultComputeLimit is the compute limit set on built transactions
+const DefaultComputeLimit = 9999
+
+// Builder builds transactions and scripts with imports resolved for one environment
+type Builder struct {
+	env templates.Environment
+}
+
+// New returns a Builder for env
+func New(env templates.Environment) *Builder {
+	return &Builder{env: env}
+}
+
+// Environment returns the environment the builder resolves imports with
+func (b *Builder) Environment() templates.Environment {
+	return b.env
+}
+
+// Script is a script and its arguments, ready to be executed
+type Script struct {
+	Code      []byte
+	Arguments []cadence.Value
+}
+
+// EncodedArguments returns the arguments encoded as JSON-Cadence
+func (s Script) EncodedArguments() ([][]byte, error) {
+	encoded := make([][]byte, len(s.Arguments))
+	for i, argument := range s.Arguments {
+		var err error
+		if encoded[i], err = jsoncdc.Encode(argument); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// transaction returns a transaction for code with its arguments added. The
+// caller sets the proposal key, payer and authorizers before signing.
+func transaction(code []byte, arguments ...cadence.Value) (*flow.Transaction, error) {
+	tx := flow.NewTransaction().
+		SetScript(code).
+		SetComputeLimit(DefaultComputeLimit)
+	for _, argument := range arguments {
+		if err := tx.AddArgument(argument); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+func script(code []byte, arguments ...cadence.Value) Script {
+	return Script{Code: code, Arguments: arguments}
+}
+
+// ------------------------------------------------------------
+// Argument encoders
+// ------------------------------------------------------------
+func uint64Value(v uint64) cadence.Value {
+	return cadence.NewUInt64(v)
+}
+
+func stringValue(v string) cadence.Value {
+	return cadence.String(v)
+}
+
+func boolValue(v bool) cadence.Value {
+	return cadence.NewBool(v)
+}
+
+func addressValue(v flow.Address) cadence.Value {
+	return cadence.NewAddress(v)
+}
+
+// tierValue encodes t as is, the contract rejects invalid tiers
+func tierValue(t model.Tier) cadence.Value {
+	return cadence.String(t)
+}
+
+// parallelValue encodes p as is, the contract rejects invalid parallels and
+// model.ParallelStandard, use a nil parallel to create an edition without one
+func parallelValue(p model.Parallel) cadence.Value {
+	return cadence.String(p)
+}
+
+// optional encodes a nil pointer as nil and any other pointer as its value
+func optional[T any](encode func(T) cadence.Value) func(*T) cadence.Value {
+	return func(v *T) cadence.Value {
+		if v == nil {
+			return cadence.NewOptional(nil)
+		}
+		return cadence.NewOptional(encode(*v))
+	}
+}
+
+func array[T any](encode func(T) cadence.Value) func([]T) cadence.Value {
+	return func(vs []T) cadence.Value {
+		values := make([]cadence.Value, len(vs))
+		for i, v := range vs {
+			values[i] = encode(v)
+		}
+		return cadence.NewArray(values)
+	}
+}
+
+// dictionary encodes a map with its keys sorted, so that built transactions
+// are deterministic
+func dictionary[K cmp.Ordered, V any](encodeKey func(K) cadence.Value, encodeValue func(V) cadence.Value) func(map[K]V) cadence.Value {
+	return func(m map[K]V) cadence.Value {
+		keys := make([]K, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		pairs := make([]cadence.KeyValuePair, len(keys))
+		for i, k := range keys {
+			pairs[i] = cadence.KeyValuePair{Key: encodeKey(k), Value: encodeValue(m[k])}
+		}
+		return cadence.NewDictionary(pairs)
+	}
+}
@@ -0,0 +1,82 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+)
+
+func decodeArguments(t *testing.T, arguments [][]byte) []cadence.Value {
+	values := make([]cadence.Value, len(arguments))
+	for i, argument := range arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		require.NoError(t, err)
+		values[i] = value
+	}
+	return values
+}
+
+func TestCreateEdition(t *testing.T) {
+	env, err := templates.NetworkEnvironment("testnet")
+	require.NoError(t, err)
+
+	maxMintSize := uint64(100)
+	tx, err := New(env).CreateEdition(1, 2, 3, model.TierCommon, model.ParallelStandard.Optional(), &maxMintSize)
+	require.NoError(t, err)
+
+	assert.Equal(t, templates.GenerateCreateEditionTransaction(env), tx.Script)
+	assert.Equal(t, uint64(DefaultComputeLimit), tx.GasLimit)
+	assert.Equal(t, []cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.NewUInt64(2),
+		cadence.NewUInt64(3),
+		cadence.String("COMMON"),
+		cadence.NewOptional(nil),
+		cadence.NewOptional(cadence.NewUInt64(100)),
+	}, decodeArguments(t, tx.Arguments))
+}
+
+func TestCreatePlaySortsMetadata(t *testing.T) {
+	tx, err := New(templates.Environment{}).CreatePlay("TOUCHDOWN", map[string]string{
+		"playerLastName":  "Smith",
+		"playerFirstName": "Alex",
+	})
+	require.NoError(t, err)
+
+	args := decodeArguments(t, tx.Arguments)
+	require.Len(t, args, 2)
+	assert.Equal(t, cadence.NewDictionary([]cadence.KeyValuePair{
+		{Key: cadence.String("playerFirstName"), Value: cadence.String("Alex")},
+		{Key: cadence.String("playerLastName"), Value: cadence.String("Smith")},
+	}), args[1])
+}
+
+func TestMintMomentNFTsMulti(t *testing.T) {
+	serialNumber := uint64(7)
+	recipient := flow.HexToAddress("01cf0e2f2f715450")
+	tx, err := New(templates.Environment{}).MintMomentNFTsMulti(recipient, []uint64{1, 2}, []uint64{1, 1}, []*uint64{&serialNumber, nil})
+	require.NoError(t, err)
+
+	args := decodeArguments(t, tx.Arguments)
+	require.Len(t, args, 4)
+	assert.Equal(t, cadence.NewAddress(recipient), args[0])
+	assert.Equal(t, cadence.NewArray([]cadence.Value{
+		cadence.NewOptional(cadence.NewUInt64(7)),
+		cadence.NewOptional(nil),
+	}), args[3])
+}
+
+func TestScriptEncodedArguments(t *testing.T) {
+	script := New(templates.Environment{}).ReadMomentNFTProperties(flow.HexToAddress("01cf0e2f2f715450"), 42)
+
+	encoded, err := script.EncodedArguments()
+	require.NoError(t, err)
+	assert.Equal(t, script.Arguments, decodeArguments(t, encoded))
+}
@@ -0,0 +1,133 @@
+// Package codec converts between Cadence values and tagged Go values.
+//
+// Composite values (structs, events, resources) map to Go structs. A struct
+// field reads the Cadence field named by its `cadence` tag, or the field whose
+// name matches the Go field name ignoring case:
+//
+//	type Edition struct {
+//		ID          uint64  `cadence:"id"`
+//		MaxMintSize *uint64 `cadence:"maxMintSize"`
+//		Parallel    string  `cadence:"parallel,optional"`
+//		Ignored     string  `cadence:"-"`
+//	}
+//
+// Decoding fails if a field is missing from the Cadence value, unless it is
+// tagged optional. Arrays decode into slices, Go arrays, or positionally into
+// structs, dictionaries into maps, and optionals into pointers. A nil
+// optional also decodes into a nil slice, map or interface.
+//
+// UFix64 and Fix64 values decode into time.Time, as seconds since the Unix
+// epoch, or into a decimal string or float64. Capabilities decode into the
+// address they were issued by, paths into strings such as "/public/x", and
+// types into their type ID. Fields of type cadence.Value receive the value as
+// is.
+package codec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onflow/cadence"
+)
+
+// Error is a value that could not be converted
+type Error struct {
+	// Path locates the value inside the outermost value, e.g. editions[2].tier
+	Path    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return "codec: " + e.Message
+	}
+	return fmt.Sprintf("codec: %s (at %s)", e.Message, e.Path)
+}
+
+func errorf(path, format string, args ...any) error {
+	return &Error{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func keyPath(path string, key cadence.Value) string {
+	return fmt.Sprintf("%s[%s]", path, key)
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	valueType = reflect.TypeOf((*cadence.Value)(nil)).Elem()
+)
+
+// ufix64Scale is the number of UFix64 and Fix64 units in one
+const ufix64Scale = 100_000_000
+
+// field is a Go struct field and the Cadence field it maps to
+type field struct {
+	index    int
+	name     string
+	optional bool
+	ufix64   bool
+}
+
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// fieldsOf returns the mapped fields of a struct type in declaration order
+func fieldsOf(t reflect.Type) []field {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]field)
+	}
+
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		tag := structField.Tag.Get("cadence")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		f := field{index: i, name: name}
+		if f.name == "" {
+			f.name = structField.Name
+		}
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "optional":
+				f.optional = true
+			case "ufix64":
+				f.ufix64 = true
+			}
+		}
+		fields = append(fields, f)
+	}
+
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// describeType returns the Cadence type ID of value, for error messages
+func describeType(value cadence.Value) string {
+	if value == nil {
+		return "nil"
+	}
+	if typ := value.Type(); typ != nil {
+		return typ.ID()
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,283 @@
+package codec
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type edition struct {
+	ID          uint64            `cadence:"id"`
+	MaxMintSize *uint64           `cadence:"maxMintSize"`
+	Tier        string            `cadence:"tier"`
+	Parallel    string            `cadence:"parallel,optional"`
+	Plays       map[uint64]bool   `cadence:"plays"`
+	Metadata    map[string]string `cadence:"metadata"`
+	Ignored     string            `cadence:"-"`
+}
+
+type moment struct {
+	ID          uint64
+	Owner       flow.Address `cadence:"owner"`
+	MintingDate time.Time    `cadence:"mintingDate"`
+	Price       string       `cadence:"price,ufix64"`
+	Raw         cadence.Value
+}
+
+func ufix64(t *testing.T, s string) cadence.UFix64 {
+	value, err := cadence.NewUFix64(s)
+	require.NoError(t, err)
+	return value
+}
+
+func editionValue(maxMintSize, tier cadence.Value) cadence.Struct {
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(4),
+		maxMintSize,
+		tier,
+		cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.NewUInt64(3), Value: cadence.NewBool(true)},
+		}),
+		cadence.NewDictionary(nil),
+	}).WithType(cadence.NewStructType(nil, "A.01.AllDay.EditionData", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "maxMintSize", Type: cadence.NewOptionalType(cadence.UInt64Type)},
+		{Identifier: "tier", Type: cadence.StringType},
+		{Identifier: "plays", Type: cadence.NewDictionaryType(cadence.UInt64Type, cadence.BoolType)},
+		{Identifier: "metadata", Type: cadence.NewDictionaryType(cadence.StringType, cadence.StringType)},
+	}, nil))
+}
+
+func TestDecodeStruct(t *testing.T) {
+	var e edition
+	require.NoError(t, Decode(editionValue(cadence.NewOptional(cadence.NewUInt64(10)), cadence.String("RARE")), &e))
+	require.NotNil(t, e.MaxMintSize)
+	assert.Equal(t, uint64(10), *e.MaxMintSize)
+	assert.Equal(t, edition{
+		ID:          4,
+		MaxMintSize: e.MaxMintSize,
+		Tier:        "RARE",
+		Plays:       map[uint64]bool{3: true},
+		Metadata:    map[string]string{},
+	}, e)
+
+	require.NoError(t, Decode(editionValue(cadence.NewOptional(nil), cadence.String("RARE")), &e))
+	assert.Nil(t, e.MaxMintSize)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	event := cadence.NewEvent([]cadence.Value{
+		cadence.NewUInt64(7),
+		cadence.NewOptional(cadence.NewAddress(flow.HexToAddress("01cf0e2f2f715450"))),
+		ufix64(t, "1700000000.50000000"),
+		ufix64(t, "12.34000000"),
+		cadence.String("raw"),
+	}).WithType(cadence.NewEventType(nil, "A.01.AllDay.Deposit", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "owner", Type: cadence.NewOptionalType(cadence.AddressType)},
+		{Identifier: "mintingDate", Type: cadence.UFix64Type},
+		{Identifier: "price", Type: cadence.UFix64Type},
+		{Identifier: "raw", Type: cadence.StringType},
+	}, nil))
+
+	var m moment
+	require.NoError(t, Decode(event, &m))
+	assert.Equal(t, moment{
+		ID:          7,
+		Owner:       flow.HexToAddress("01cf0e2f2f715450"),
+		MintingDate: time.Unix(1700000000, 500_000_000).UTC(),
+		Price:       "12.34000000",
+		Raw:         cadence.String("raw"),
+	}, m)
+}
+
+func TestDecodeArray(t *testing.T) {
+	properties := cadence.NewArray([]cadence.Value{
+		cadence.NewUInt64(7),
+		cadence.NewUInt64(4),
+		ufix64(t, "1.00000000"),
+	})
+
+	var positional struct {
+		ID          uint64    `cadence:"id"`
+		EditionID   uint64    `cadence:"editionID"`
+		MintingDate time.Time `cadence:"mintingDate"`
+	}
+	require.NoError(t, Decode(properties, &positional))
+	assert.Equal(t, uint64(4), positional.EditionID)
+	assert.Equal(t, time.Unix(1, 0).UTC(), positional.MintingDate)
+
+	var values []cadence.Value
+	require.NoError(t, Decode(properties, &values))
+	assert.Len(t, values, 3)
+
+	ids := cadence.NewArray([]cadence.Value{cadence.NewUInt64(1), cadence.NewUInt64(2)})
+	var slice []uint64
+	require.NoError(t, Decode(ids, &slice))
+	assert.Equal(t, []uint64{1, 2}, slice)
+
+	var array [2]uint64
+	require.NoError(t, Decode(ids, &array))
+	assert.Equal(t, [2]uint64{1, 2}, array)
+
+	var pointer *[]uint64
+	require.NoError(t, Decode(cadence.NewOptional(ids), &pointer))
+	require.NotNil(t, pointer)
+	assert.Equal(t, []uint64{1, 2}, *pointer)
+
+	require.NoError(t, Decode(cadence.NewOptional(nil), &pointer))
+	assert.Nil(t, pointer)
+}
+
+func TestDecodeNumbers(t *testing.T) {
+	var u8 uint8
+	require.NoError(t, Decode(cadence.NewUInt64(255), &u8))
+	assert.Equal(t, uint8(255), u8)
+
+	var i int
+	require.NoError(t, Decode(cadence.NewInt(-3), &i))
+	assert.Equal(t, -3, i)
+
+	var f float64
+	require.NoError(t, Decode(ufix64(t, "0.25000000"), &f))
+	assert.Equal(t, 0.25, f)
+}
+
+func TestDecodePathsTypesAndCapabilities(t *testing.T) {
+	var target struct {
+		Path       string       `cadence:"path"`
+		Type       string       `cadence:"type"`
+		Capability flow.Address `cadence:"capability"`
+	}
+	value := cadence.NewArray([]cadence.Value{
+		cadence.MustNewPath(common.PathDomainPublic, "AllDayNFTCollection"),
+		cadence.NewTypeValue(cadence.StringType),
+		cadence.NewCapability(4, cadence.Address(flow.HexToAddress("01")), cadence.StringType),
+	})
+	require.NoError(t, Decode(value, &target))
+	assert.Equal(t, "/public/AllDayNFTCollection", target.Path)
+	assert.Equal(t, "String", target.Type)
+	assert.Equal(t, flow.HexToAddress("01"), target.Capability)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var e edition
+	err := Decode(editionValue(cadence.NewOptional(cadence.String("10")), cadence.String("RARE")), &e)
+	assert.EqualError(t, err, `codec: cannot decode String into uint64 (at maxMintSize)`)
+
+	err = Decode(editionValue(cadence.NewOptional(nil), cadence.NewUInt64(1)), &e)
+	assert.EqualError(t, err, `codec: cannot decode UInt64 into string (at tier)`)
+
+	var missing struct {
+		NumMinted uint64 `cadence:"numMinted"`
+	}
+	err = Decode(editionValue(cadence.NewOptional(nil), cadence.String("RARE")), &missing)
+	assert.EqualError(t, err, `codec: missing field "numMinted" in A.01.AllDay.EditionData`)
+
+	var editions []edition
+	err = Decode(cadence.NewArray([]cadence.Value{editionValue(cadence.NewOptional(nil), cadence.NewBool(true))}), &editions)
+	var codecErr *Error
+	require.ErrorAs(t, err, &codecErr)
+	assert.Equal(t, "[0].tier", codecErr.Path)
+
+	var mintingDate uint64
+	err = Decode(ufix64(t, "1.50000000"), &mintingDate)
+	assert.EqualError(t, err, `codec: cannot decode UFix64 into uint64, use time.Time, string or float64`)
+
+	var u8 uint8
+	err = Decode(cadence.NewUInt64(256), &u8)
+	assert.EqualError(t, err, `codec: UInt64 256 overflows uint8`)
+
+	var id uint64
+	err = Decode(cadence.NewOptional(nil), &id)
+	assert.EqualError(t, err, `codec: cannot decode nil into uint64, use a pointer`)
+
+	assert.EqualError(t, Decode(cadence.NewUInt64(1), id), `codec: target must be a non-nil pointer, got uint64`)
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	maxMintSize := uint64(10)
+	e := edition{
+		ID:          4,
+		MaxMintSize: &maxMintSize,
+		Tier:        "RARE",
+		Parallel:    "Ruby",
+		Plays:       map[uint64]bool{3: true, 1: false},
+		Metadata:    map[string]string{"b": "2", "a": "1"},
+		Ignored:     "ignored",
+	}
+
+	value, err := Encode(e)
+	require.NoError(t, err)
+	s, ok := value.(cadence.Struct)
+	require.True(t, ok)
+	assert.Equal(t, "edition", s.Type().ID())
+
+	fields := s.FieldsMappedByName()
+	assert.Len(t, fields, 6)
+	assert.Equal(t, cadence.NewOptional(cadence.NewUInt64(10)), fields["maxMintSize"])
+	plays := fields["plays"].(cadence.Dictionary)
+	assert.Equal(t, cadence.NewUInt64(1), plays.Pairs[0].Key)
+	assert.Equal(t, cadence.NewUInt64(3), plays.Pairs[1].Key)
+
+	var decoded edition
+	require.NoError(t, Decode(value, &decoded))
+	e.Ignored = ""
+	assert.Equal(t, e, decoded)
+
+	m := moment{
+		ID:          7,
+		Owner:       flow.HexToAddress("01cf0e2f2f715450"),
+		MintingDate: time.Unix(1700000000, 500_000_000).UTC(),
+		Price:       "12.34000000",
+		Raw:         cadence.String("raw"),
+	}
+	value, err = Encode(m)
+	require.NoError(t, err)
+	fields = value.(cadence.Composite).FieldsMappedByName()
+	assert.Equal(t, ufix64(t, "12.34000000"), fields["price"])
+	assert.Equal(t, cadence.NewAddress(flow.HexToAddress("01cf0e2f2f715450")), fields["owner"])
+
+	var decodedMoment moment
+	require.NoError(t, Decode(value, &decodedMoment))
+	assert.Equal(t, m, decodedMoment)
+}
+
+func TestLargeUFix64RoundTrip(t *testing.T) {
+	largest := cadence.UFix64(math.MaxUint64)
+
+	var price string
+	require.NoError(t, Decode(largest, &price))
+	assert.Equal(t, "184467440737.09551615", price)
+
+	var f float64
+	require.NoError(t, Decode(largest, &f))
+	assert.InEpsilon(t, 184467440737.09551615, f, 1e-15)
+
+	m := moment{MintingDate: time.Unix(184467440737, 95_516_150).UTC(), Price: price, Raw: cadence.String("raw")}
+	value, err := Encode(m)
+	require.NoError(t, err)
+	fields := value.(cadence.Composite).FieldsMappedByName()
+	assert.Equal(t, largest, fields["price"])
+	assert.Equal(t, largest, fields["mintingDate"])
+
+	var decoded moment
+	require.NoError(t, Decode(value, &decoded))
+	assert.Equal(t, m.MintingDate, decoded.MintingDate)
+	assert.Equal(t, price, decoded.Price)
+}
+
+func TestEncodeErrors(t *testing.T) {
+	_, err := Encode(moment{MintingDate: time.Unix(0, 0), Price: "free"})
+	assert.ErrorContains(t, err, `cannot encode "free" as UFix64`)
+	assert.ErrorContains(t, err, "(at price)")
+
+	_, err = Encode(make(chan int))
+	assert.EqualError(t, err, "codec: cannot encode chan int")
+}
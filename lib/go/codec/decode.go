@@ -0,0 +1,256 @@
+package codec
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence"
+)
+
+// Decode stores the Go representation of value in the value pointed to by target
+func Decode(value cadence.Value, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return errorf("", "target must be a non-nil pointer, got %T", target)
+	}
+	return decode("", value, v.Elem())
+}
+
+func decode(path string, value cadence.Value, target reflect.Value) error {
+	// Fields typed as cadence.Value, or an interface it satisfies, get the value as is
+	if target.Kind() == reflect.Interface && valueType.Implements(target.Type()) {
+		if value == nil {
+			target.SetZero()
+			return nil
+		}
+		target.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	if optional, ok := value.(cadence.Optional); ok {
+		if optional.Value == nil {
+			switch target.Kind() {
+			case reflect.Pointer, reflect.Slice, reflect.Map:
+				target.SetZero()
+				return nil
+			}
+			return errorf(path, "cannot decode nil into %s, use a pointer", target.Type())
+		}
+		return decode(path, optional.Value, target)
+	}
+
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return decode(path, value, target.Elem())
+	}
+
+	switch value := value.(type) {
+	case cadence.Composite:
+		return decodeComposite(path, value, target)
+	case cadence.Array:
+		return decodeArray(path, value, target)
+	case cadence.Dictionary:
+		return decodeDictionary(path, value, target)
+	case cadence.UFix64:
+		return decodeFixedPoint(path, value, new(big.Int).SetUint64(uint64(value)), value.String(), target)
+	case cadence.Fix64:
+		return decodeFixedPoint(path, value, big.NewInt(int64(value)), value.String(), target)
+	case cadence.String:
+		return decodeString(path, value, string(value), target)
+	case cadence.Character:
+		return decodeString(path, value, string(value), target)
+	case cadence.Bool:
+		if target.Kind() != reflect.Bool {
+			return mismatch(path, value, target)
+		}
+		target.SetBool(bool(value))
+		return nil
+	case cadence.Address:
+		return decodeAddress(path, value, target)
+	case cadence.Capability:
+		return decodeAddress(path, value.Address, target)
+	case cadence.Path:
+		return decodeString(path, value, value.String(), target)
+	case cadence.TypeValue:
+		if value.StaticType == nil {
+			return decodeString(path, value, "", target)
+		}
+		return decodeString(path, value, value.StaticType.ID(), target)
+	case interface{ Big() *big.Int }:
+		return decodeInteger(path, value.(cadence.Value), value.Big(), target)
+	}
+
+	// Fixed size integers are Go integers
+	raw := reflect.ValueOf(value)
+	switch raw.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decodeInteger(path, value, big.NewInt(raw.Int()), target)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return decodeInteger(path, value, new(big.Int).SetUint64(raw.Uint()), target)
+	}
+
+	return mismatch(path, value, target)
+}
+
+func mismatch(path string, value cadence.Value, target reflect.Value) error {
+	return errorf(path, "cannot decode %s into %s", describeType(value), target.Type())
+}
+
+func decodeComposite(path string, composite cadence.Composite, target reflect.Value) error {
+	if target.Kind() != reflect.Struct {
+		return mismatch(path, composite, target)
+	}
+
+	values := composite.FieldsMappedByName()
+	for _, f := range fieldsOf(target.Type()) {
+		value, ok := values[f.name]
+		if !ok {
+			// Fall back to a case-insensitive match, so that ID maps to id
+			for name, v := range values {
+				if strings.EqualFold(name, f.name) {
+					value, ok = v, true
+					break
+				}
+			}
+		}
+		if !ok {
+			if f.optional {
+				continue
+			}
+			return errorf(path, "missing field %q in %s", f.name, describeType(composite))
+		}
+		if err := decode(fieldPath(path, f.name), value, target.Field(f.index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeArray(path string, array cadence.Array, target reflect.Value) error {
+	switch target.Kind() {
+	case reflect.Slice:
+		slice := reflect.MakeSlice(target.Type(), len(array.Values), len(array.Values))
+		for i, value := range array.Values {
+			if err := decode(indexPath(path, i), value, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		target.Set(slice)
+		return nil
+
+	case reflect.Array:
+		if target.Len() != len(array.Values) {
+			return errorf(path, "cannot decode %d elements into %s", len(array.Values), target.Type())
+		}
+		for i, value := range array.Values {
+			if err := decode(indexPath(path, i), value, target.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Struct:
+		// Arrays of mixed values, such as [AnyStruct], decode positionally
+		fields := fieldsOf(target.Type())
+		if len(fields) != len(array.Values) {
+			return errorf(path, "cannot decode %d elements into %s with %d fields", len(array.Values), target.Type(), len(fields))
+		}
+		for i, f := range fields {
+			if err := decode(fieldPath(path, f.name), array.Values[i], target.Field(f.index)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return mismatch(path, array, target)
+}
+
+func decodeDictionary(path string, dictionary cadence.Dictionary, target reflect.Value) error {
+	if target.Kind() != reflect.Map {
+		return mismatch(path, dictionary, target)
+	}
+
+	m := reflect.MakeMapWithSize(target.Type(), len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		key := reflect.New(target.Type().Key()).Elem()
+		if err := decode(keyPath(path, pair.Key), pair.Key, key); err != nil {
+			return err
+		}
+		element := reflect.New(target.Type().Elem()).Elem()
+		if err := decode(keyPath(path, pair.Key), pair.Value, element); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, element)
+	}
+	target.Set(m)
+	return nil
+}
+
+// decodeFixedPoint decodes a UFix64 or Fix64 of units. Units are a big.Int
+// because neither int64 nor uint64 holds both.
+func decodeFixedPoint(path string, value cadence.Value, units *big.Int, decimal string, target reflect.Value) error {
+	switch {
+	case target.Type() == timeType:
+		seconds, fraction := new(big.Int).QuoRem(units, big.NewInt(ufix64Scale), new(big.Int))
+		target.Set(reflect.ValueOf(time.Unix(seconds.Int64(), fraction.Int64()*10).UTC()))
+		return nil
+	case target.Kind() == reflect.String:
+		target.SetString(decimal)
+		return nil
+	case target.Kind() == reflect.Float32 || target.Kind() == reflect.Float64:
+		f, _ := new(big.Float).SetInt(units).Float64()
+		target.SetFloat(f / ufix64Scale)
+		return nil
+	case target.CanInt() || target.CanUint():
+		// Truncating to whole units silently loses precision, so refuse
+		return errorf(path, "cannot decode %s into %s, use time.Time, string or float64", describeType(value), target.Type())
+	}
+	return mismatch(path, value, target)
+}
+
+func decodeString(path string, value cadence.Value, s string, target reflect.Value) error {
+	if target.Kind() != reflect.String {
+		return mismatch(path, value, target)
+	}
+	target.SetString(s)
+	return nil
+}
+
+func decodeAddress(path string, address cadence.Address, target reflect.Value) error {
+	switch {
+	case target.Kind() == reflect.Array && target.Type().Elem().Kind() == reflect.Uint8 && target.Len() == len(address):
+		// flow.Address and cadence.Address
+		reflect.Copy(target, reflect.ValueOf(address[:]))
+		return nil
+	case target.Kind() == reflect.String:
+		target.SetString(address.Hex())
+		return nil
+	}
+	return mismatch(path, address, target)
+}
+
+func decodeInteger(path string, value cadence.Value, n *big.Int, target reflect.Value) error {
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !n.IsInt64() || target.OverflowInt(n.Int64()) {
+			return errorf(path, "%s %s overflows %s", describeType(value), n, target.Type())
+		}
+		target.SetInt(n.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !n.IsUint64() || target.OverflowUint(n.Uint64()) {
+			return errorf(path, "%s %s overflows %s", describeType(value), n, target.Type())
+		}
+		target.SetUint(n.Uint64())
+		return nil
+	}
+	if target.Type() == reflect.TypeOf(big.Int{}) {
+		target.Set(reflect.ValueOf(*new(big.Int).Set(n)))
+		return nil
+	}
+	return mismatch(path, value, target)
+}
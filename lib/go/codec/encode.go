@@ -0,0 +1,298 @@
+package codec
+
+import (
+	"cmp"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/onflow/cadence"
+)
+
+// Encode returns the Cadence representation of v. Structs become Cadence
+// structs with a type named after the Go type, pointers become optionals,
+// slices and arrays become arrays, and maps become dictionaries with their
+// keys in sorted order. Integers become the Cadence integer of the same size,
+// float64 and time.Time become UFix64, and [8]byte arrays such as
+// flow.Address become addresses. Strings tagged ufix64 are parsed as UFix64.
+func Encode(v any) (cadence.Value, error) {
+	if v == nil {
+		return nil, errorf("", "cannot encode nil")
+	}
+	return encode("", reflect.ValueOf(v), false)
+}
+
+var (
+	bigIntType = reflect.TypeOf(big.Int{})
+
+	// structTypes caches the Cadence type of each encoded Go struct type.
+	// Recursive struct types are not supported.
+	structTypes sync.Map // map[reflect.Type]*cadence.StructType
+)
+
+func encode(path string, v reflect.Value, ufix64 bool) (cadence.Value, error) {
+	if v.Type().Implements(valueType) {
+		if v.Kind() == reflect.Interface && v.IsNil() {
+			return nil, errorf(path, "cannot encode nil %s", v.Type())
+		}
+		return v.Interface().(cadence.Value), nil
+	}
+
+	switch {
+	case v.Type() == timeType:
+		t := v.Interface().(time.Time)
+		if t.Before(time.Unix(0, 0)) {
+			return nil, errorf(path, "cannot encode %s before the Unix epoch as UFix64", t)
+		}
+		return cadence.UFix64(uint64(t.Unix())*ufix64Scale + uint64(t.Nanosecond()/10)), nil
+	case v.Type() == bigIntType:
+		n := v.Interface().(big.Int)
+		return cadence.NewIntFromBig(&n), nil
+	case isAddress(v.Type()):
+		var address cadence.Address
+		reflect.Copy(reflect.ValueOf(&address).Elem(), v)
+		return address, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return cadence.NewBool(v.Bool()), nil
+	case reflect.String:
+		if ufix64 {
+			value, err := cadence.NewUFix64(v.String())
+			if err != nil {
+				return nil, errorf(path, "cannot encode %q as UFix64: %s", v.String(), err)
+			}
+			return value, nil
+		}
+		value, err := cadence.NewString(v.String())
+		if err != nil {
+			return nil, errorf(path, "%s", err)
+		}
+		return value, nil
+	case reflect.Int:
+		return cadence.NewInt(int(v.Int())), nil
+	case reflect.Int8:
+		return cadence.NewInt8(int8(v.Int())), nil
+	case reflect.Int16:
+		return cadence.NewInt16(int16(v.Int())), nil
+	case reflect.Int32:
+		return cadence.NewInt32(int32(v.Int())), nil
+	case reflect.Int64:
+		return cadence.NewInt64(v.Int()), nil
+	case reflect.Uint:
+		return cadence.NewUInt(uint(v.Uint())), nil
+	case reflect.Uint8:
+		return cadence.NewUInt8(uint8(v.Uint())), nil
+	case reflect.Uint16:
+		return cadence.NewUInt16(uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return cadence.NewUInt32(uint32(v.Uint())), nil
+	case reflect.Uint64:
+		return cadence.NewUInt64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		value, err := cadence.NewUFix64(strconv.FormatFloat(v.Float(), 'f', 8, 64))
+		if err != nil {
+			return nil, errorf(path, "cannot encode %v as UFix64: %s", v.Float(), err)
+		}
+		return value, nil
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		value, err := encode(path, v.Elem(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(value), nil
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, errorf(path, "cannot encode nil %s", v.Type())
+		}
+		return encode(path, v.Elem(), ufix64)
+
+	case reflect.Slice, reflect.Array:
+		values := make([]cadence.Value, v.Len())
+		for i := range values {
+			value, err := encode(indexPath(path, i), v.Index(i), ufix64)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		arrayType, err := typeOf(path, v.Type(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewArray(values).WithType(arrayType.(cadence.ArrayType)), nil
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, compareKeys)
+		pairs := make([]cadence.KeyValuePair, len(keys))
+		for i, key := range keys {
+			k, err := encode(path, key, false)
+			if err != nil {
+				return nil, err
+			}
+			value, err := encode(keyPath(path, k), v.MapIndex(key), ufix64)
+			if err != nil {
+				return nil, err
+			}
+			pairs[i] = cadence.KeyValuePair{Key: k, Value: value}
+		}
+		dictionaryType, err := typeOf(path, v.Type(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewDictionary(pairs).WithType(dictionaryType.(*cadence.DictionaryType)), nil
+
+	case reflect.Struct:
+		fields := fieldsOf(v.Type())
+		values := make([]cadence.Value, len(fields))
+		for i, f := range fields {
+			value, err := encode(fieldPath(path, f.name), v.Field(f.index), f.ufix64)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		structType, err := typeOf(path, v.Type(), false)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewStruct(values).WithType(structType.(*cadence.StructType)), nil
+	}
+
+	return nil, errorf(path, "cannot encode %s", v.Type())
+}
+
+// typeOf returns the Cadence type Encode produces for values of type t
+func typeOf(path string, t reflect.Type, ufix64 bool) (cadence.Type, error) {
+	if t.Implements(valueType) {
+		return cadence.AnyStructType, nil
+	}
+
+	switch {
+	case t == timeType:
+		return cadence.UFix64Type, nil
+	case t == bigIntType:
+		return cadence.IntType, nil
+	case isAddress(t):
+		return cadence.AddressType, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return cadence.BoolType, nil
+	case reflect.String:
+		if ufix64 {
+			return cadence.UFix64Type, nil
+		}
+		return cadence.StringType, nil
+	case reflect.Int:
+		return cadence.IntType, nil
+	case reflect.Int8:
+		return cadence.Int8Type, nil
+	case reflect.Int16:
+		return cadence.Int16Type, nil
+	case reflect.Int32:
+		return cadence.Int32Type, nil
+	case reflect.Int64:
+		return cadence.Int64Type, nil
+	case reflect.Uint:
+		return cadence.UIntType, nil
+	case reflect.Uint8:
+		return cadence.UInt8Type, nil
+	case reflect.Uint16:
+		return cadence.UInt16Type, nil
+	case reflect.Uint32:
+		return cadence.UInt32Type, nil
+	case reflect.Uint64:
+		return cadence.UInt64Type, nil
+	case reflect.Float32, reflect.Float64:
+		return cadence.UFix64Type, nil
+	case reflect.Interface:
+		return cadence.AnyStructType, nil
+
+	case reflect.Pointer:
+		elem, err := typeOf(path, t.Elem(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptionalType(elem), nil
+
+	case reflect.Slice:
+		elem, err := typeOf(path, t.Elem(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewVariableSizedArrayType(elem), nil
+
+	case reflect.Array:
+		elem, err := typeOf(path, t.Elem(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewConstantSizedArrayType(uint(t.Len()), elem), nil
+
+	case reflect.Map:
+		key, err := typeOf(path, t.Key(), false)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := typeOf(path, t.Elem(), ufix64)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewDictionaryType(key, elem), nil
+
+	case reflect.Struct:
+		if cached, ok := structTypes.Load(t); ok {
+			return cached.(*cadence.StructType), nil
+		}
+		var fields []cadence.Field
+		for _, f := range fieldsOf(t) {
+			fieldType, err := typeOf(fieldPath(path, f.name), t.Field(f.index).Type, f.ufix64)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, cadence.Field{Identifier: f.name, Type: fieldType})
+		}
+		structType, _ := structTypes.LoadOrStore(t, cadence.NewStructType(nil, t.Name(), fields, nil))
+		return structType.(*cadence.StructType), nil
+	}
+
+	return nil, errorf(path, "cannot encode %s", t)
+}
+
+// isAddress is true for [8]byte types such as flow.Address
+func isAddress(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8 && t.Len() == len(cadence.Address{})
+}
+
+// compareKeys orders map keys so that dictionaries encode deterministically
+func compareKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Bool:
+		switch {
+		case a.Bool() == b.Bool():
+			return 0
+		case b.Bool():
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,231 @@
+package events
+
+import (
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+func init() {
+	register[ContractInitialized]()
+	register[Withdraw]()
+	register[Deposit]()
+	register[SeriesCreated]()
+	register[SeriesClosed]()
+	register[SetCreated]()
+	register[PlayCreated]()
+	register[EditionCreated]()
+	register[EditionClosed]()
+	register[MomentNFTMinted]()
+	register[MomentNFTBurned]()
+	register[MomentNFTDestroyed]()
+	register[BadgeCreated]()
+	register[BadgeUpdated]()
+	register[BadgeAddedToEntity]()
+	register[BadgeRemovedFromEntity]()
+	register[BadgeDeleted]()
+	register[BadgeEntitiesIndexed]()
+}
+
+// ------------------------------------------------------------
+// Contract
+// ------------------------------------------------------------
+
+// ContractInitialized is emitted when the AllDay contract is deployed
+type ContractInitialized struct{}
+
+func (ContractInitialized) EventName() string { return "AllDay.ContractInitialized" }
+
+// ------------------------------------------------------------
+// Collection
+// ------------------------------------------------------------
+
+// Withdraw is emitted when a moment is withdrawn from a collection
+type Withdraw struct {
+	ID uint64 `cadence:"id"`
+	// From is nil if the collection is not stored in an account
+	From *flow.Address `cadence:"from"`
+}
+
+func (Withdraw) EventName() string { return "AllDay.Withdraw" }
+
+// Deposit is emitted when a moment is deposited into a collection
+type Deposit struct {
+	ID uint64 `cadence:"id"`
+	// To is nil if the collection is not stored in an account
+	To *flow.Address `cadence:"to"`
+}
+
+func (Deposit) EventName() string { return "AllDay.Deposit" }
+
+// ------------------------------------------------------------
+// Series
+// ------------------------------------------------------------
+
+// SeriesCreated is emitted when an admin creates a series
+type SeriesCreated struct {
+	ID   uint64 `cadence:"id"`
+	Name string `cadence:"name"`
+}
+
+func (SeriesCreated) EventName() string { return "AllDay.SeriesCreated" }
+
+// SeriesClosed is emitted when an admin closes a series
+type SeriesClosed struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (SeriesClosed) EventName() string { return "AllDay.SeriesClosed" }
+
+// ------------------------------------------------------------
+// Sets
+// ------------------------------------------------------------
+
+// SetCreated is emitted when an admin creates a set
+type SetCreated struct {
+	ID   uint64 `cadence:"id"`
+	Name string `cadence:"name"`
+}
+
+func (SetCreated) EventName() string { return "AllDay.SetCreated" }
+
+// ------------------------------------------------------------
+// Plays
+// ------------------------------------------------------------
+
+// PlayCreated is emitted when an admin creates a play
+type PlayCreated struct {
+	ID             uint64            `cadence:"id"`
+	Classification string            `cadence:"classification"`
+	Metadata       map[string]string `cadence:"metadata"`
+}
+
+func (PlayCreated) EventName() string { return "AllDay.PlayCreated" }
+
+// ------------------------------------------------------------
+// Editions
+// ------------------------------------------------------------
+
+// EditionCreated is emitted when an admin creates an edition
+type EditionCreated struct {
+	ID       uint64 `cadence:"id"`
+	SeriesID uint64 `cadence:"seriesID"`
+	SetID    uint64 `cadence:"setID"`
+	PlayID   uint64 `cadence:"playID"`
+	// MaxMintSize is nil if the edition can be minted without limit
+	MaxMintSize *uint64        `cadence:"maxMintSize"`
+	Tier        model.Tier     `cadence:"tier"`
+	Parallel    model.Parallel `cadence:"parallel"`
+}
+
+func (EditionCreated) EventName() string { return "AllDay.EditionCreated" }
+
+// EditionClosed is emitted when an admin closes an edition
+type EditionClosed struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (EditionClosed) EventName() string { return "AllDay.EditionClosed" }
+
+// ------------------------------------------------------------
+// Moment NFTs
+// ------------------------------------------------------------
+
+// MomentNFTMinted is emitted when a moment is minted
+type MomentNFTMinted struct {
+	ID           uint64 `cadence:"id"`
+	EditionID    uint64 `cadence:"editionID"`
+	SerialNumber uint64 `cadence:"serialNumber"`
+}
+
+func (MomentNFTMinted) EventName() string { return "AllDay.MomentNFTMinted" }
+
+// MomentNFTBurned is declared by the contract but no longer emitted, burns
+// emit MomentNFTDestroyed
+type MomentNFTBurned struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (MomentNFTBurned) EventName() string { return "AllDay.MomentNFTBurned" }
+
+// MomentNFTDestroyed is the ResourceDestroyed event emitted when a moment is
+// destroyed
+type MomentNFTDestroyed struct {
+	ID           uint64    `cadence:"id"`
+	EditionID    uint64    `cadence:"editionID"`
+	SerialNumber uint64    `cadence:"serialNumber"`
+	MintingDate  time.Time `cadence:"mintingDate"`
+}
+
+func (MomentNFTDestroyed) EventName() string { return "AllDay.NFT.ResourceDestroyed" }
+
+// ------------------------------------------------------------
+// Badges
+// ------------------------------------------------------------
+
+// BadgeCreated is emitted when an admin creates a badge
+type BadgeCreated struct {
+	Slug        string            `cadence:"slug"`
+	Title       string            `cadence:"title"`
+	Description string            `cadence:"description"`
+	Visible     bool              `cadence:"visible"`
+	SlugV2      string            `cadence:"slugV2"`
+	Metadata    map[string]string `cadence:"metadata"`
+}
+
+func (BadgeCreated) EventName() string { return "AllDay.BadgeCreated" }
+
+// BadgeUpdated is emitted when an admin updates a badge, with the badge's
+// values after the update
+type BadgeUpdated struct {
+	Slug        string            `cadence:"slug"`
+	Title       string            `cadence:"title"`
+	Description string            `cadence:"description"`
+	Visible     bool              `cadence:"visible"`
+	SlugV2      string            `cadence:"slugV2"`
+	Metadata    map[string]string `cadence:"metadata"`
+}
+
+func (BadgeUpdated) EventName() string { return "AllDay.BadgeUpdated" }
+
+// BadgeAddedToEntity is emitted when an admin adds a badge to a play, an
+// edition, a moment, a set or a series
+type BadgeAddedToEntity struct {
+	BadgeSlug  string            `cadence:"badgeSlug"`
+	EntityType string            `cadence:"entityType"`
+	EntityID   uint64            `cadence:"entityID"`
+	Metadata   map[string]string `cadence:"metadata"`
+}
+
+func (BadgeAddedToEntity) EventName() string { return "AllDay.BadgeAddedToEntity" }
+
+// BadgeRemovedFromEntity is emitted when an admin removes a badge from a
+// play, an edition, a moment, a set or a series
+type BadgeRemovedFromEntity struct {
+	BadgeSlug  string `cadence:"badgeSlug"`
+	EntityType string `cadence:"entityType"`
+	EntityID   uint64 `cadence:"entityID"`
+}
+
+func (BadgeRemovedFromEntity) EventName() string { return "AllDay.BadgeRemovedFromEntity" }
+
+// BadgeDeleted is emitted when an admin deletes a badge
+type BadgeDeleted struct {
+	Slug string `cadence:"slug"`
+}
+
+func (BadgeDeleted) EventName() string { return "AllDay.BadgeDeleted" }
+
+// BadgeEntitiesIndexed is emitted when an admin indexes a batch of the
+// entities with badges attached before the contract kept an index of each
+// badge's entities. Batches are run until Offset plus Count reaches Total.
+type BadgeEntitiesIndexed struct {
+	EntityType string `cadence:"entityType"`
+	Offset     uint64 `cadence:"offset"`
+	Count      uint64 `cadence:"count"`
+	Total      uint64 `cadence:"total"`
+}
+
+func (BadgeEntitiesIndexed) EventName() string { return "AllDay.BadgeEntitiesIndexed" }
@@ -0,0 +1,171 @@
+// Package events decodes the events the AllDay and PackNFT contracts emit
+// into Go values.
+//
+// A Decoder is bound to the contract addresses of one environment, so that
+// events of the same name emitted by contracts on other accounts are not
+// mistaken for them:
+//
+//	decoder := events.NewDecoder(env)
+//	for _, event := range result.Events {
+//		e, err := decoder.Decode(event)
+//		if errors.Is(err, events.ErrUnknownEvent) {
+//			continue
+//		}
+//		switch e := e.(type) {
+//		case events.EditionCreated:
+//			...
+//		}
+//	}
+package events
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/ccf"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+)
+
+// ErrUnknownEvent is returned for events that are not emitted by the
+// contracts of the decoder's environment
+var ErrUnknownEvent = errors.New("events: unknown event")
+
+// Event is a decoded contract event
+type Event interface {
+	// EventName is the name of the event qualified by its contract, such as
+	// AllDay.EditionCreated or AllDay.NFT.ResourceDestroyed
+	EventName() string
+}
+
+// decoders holds a decoder for every event type, keyed by its name
+var decoders = map[string]func(cadence.Event) (Event, error){}
+
+func register[T Event]() {
+	var zero T
+	decoders[zero.EventName()] = func(value cadence.Event) (Event, error) {
+		var event T
+		if err := codec.Decode(value, &event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+}
+
+// contractAddresses maps the contracts whose events are decoded to their
+// address in an environment
+var contractAddresses = map[string]func(templates.Environment) string{
+	"AllDay":  func(env templates.Environment) string { return env.AllDayAddress },
+	"PackNFT": func(env templates.Environment) string { return env.PackNFTAddress },
+}
+
+// Names returns the names of every event that can be decoded in sorted order
+func Names() []string {
+	names := make([]string, 0, len(decoders))
+	for name := range decoders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TypeID returns the fully qualified type ID of the event named name on env,
+// such as A.e4cf4bdc1751c65d.AllDay.EditionCreated for AllDay.EditionCreated
+// on mainnet
+func TypeID(env templates.Environment, name string) (string, error) {
+	contract, _, _ := strings.Cut(name, ".")
+	addressOf, ok := contractAddresses[contract]
+	if !ok {
+		return "", fmt.Errorf("events: unknown contract %q in event %q", contract, name)
+	}
+	address := addressOf(env)
+	if address == "" {
+		return "", fmt.Errorf("events: no %s address in the %q environment", contract, env.Network)
+	}
+	return fmt.Sprintf("A.%s.%s", flow.HexToAddress(address).Hex(), name), nil
+}
+
+// TypeIDOf returns the fully qualified type ID of event on env
+func TypeIDOf(env templates.Environment, event Event) (string, error) {
+	return TypeID(env, event.EventName())
+}
+
+// Decoder decodes the events of the contracts of one environment
+type Decoder struct {
+	// typeIDs maps the fully qualified type IDs to event names
+	typeIDs map[string]string
+}
+
+// NewDecoder returns a decoder for the events of the contracts deployed at
+// the addresses in env. Events of contracts without an address in env are
+// unknown to the decoder.
+func NewDecoder(env templates.Environment) *Decoder {
+	d := &Decoder{typeIDs: map[string]string{}}
+	for name := range decoders {
+		if typeID, err := TypeID(env, name); err == nil {
+			d.typeIDs[typeID] = name
+		}
+	}
+	return d
+}
+
+// TypeIDs returns the fully qualified type IDs of the events the decoder
+// knows in sorted order, such as for subscribing to them
+func (d *Decoder) TypeIDs() []string {
+	typeIDs := make([]string, 0, len(d.typeIDs))
+	for typeID := range d.typeIDs {
+		typeIDs = append(typeIDs, typeID)
+	}
+	sort.Strings(typeIDs)
+	return typeIDs
+}
+
+// Decode decodes an event returned by an access node or an emulator
+// transaction result
+func (d *Decoder) Decode(event flow.Event) (Event, error) {
+	if event.Value.EventType == nil {
+		return d.DecodePayload(event.Payload)
+	}
+	return d.DecodeValue(event.Value)
+}
+
+// DecodePayload decodes a CCF or JSON-Cadence encoded event, such as the
+// payload of the flow-go events the emulator returns by block height
+func (d *Decoder) DecodePayload(payload []byte) (Event, error) {
+	var value cadence.Value
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(payload), []byte("{")) {
+		value, err = jsoncdc.Decode(nil, payload)
+	} else {
+		value, err = ccf.Decode(nil, payload)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("events: decode payload: %w", err)
+	}
+	event, ok := value.(cadence.Event)
+	if !ok {
+		return nil, fmt.Errorf("events: decode payload: expected an event, got %s", value.Type().ID())
+	}
+	return d.DecodeValue(event)
+}
+
+// DecodeValue decodes a Cadence event value
+func (d *Decoder) DecodeValue(value cadence.Event) (Event, error) {
+	typeID := value.Type().ID()
+	name, ok := d.typeIDs[typeID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownEvent, typeID)
+	}
+	event, err := decoders[name](value)
+	if err != nil {
+		return nil, fmt.Errorf("events: decode %s: %w", name, err)
+	}
+	return event, nil
+}
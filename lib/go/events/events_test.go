@@ -0,0 +1,128 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/encoding/ccf"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+)
+
+var testEnv = templates.Environment{
+	Network:        "testing",
+	AllDayAddress:  "0xe4cf4bdc1751c65d",
+	PackNFTAddress: "e4cf4bdc1751c65d",
+}
+
+func eventValue(address string, name string, fields []cadence.Field, values ...cadence.Value) cadence.Event {
+	location := common.NewAddressLocation(nil, common.Address(flow.HexToAddress(address)), "AllDay")
+	return cadence.NewEvent(values).WithType(cadence.NewEventType(location, name, fields, nil))
+}
+
+func editionCreated(address string) cadence.Event {
+	return eventValue(address, "AllDay.EditionCreated", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "seriesID", Type: cadence.UInt64Type},
+		{Identifier: "setID", Type: cadence.UInt64Type},
+		{Identifier: "playID", Type: cadence.UInt64Type},
+		{Identifier: "maxMintSize", Type: cadence.NewOptionalType(cadence.UInt64Type)},
+		{Identifier: "tier", Type: cadence.StringType},
+		{Identifier: "parallel", Type: cadence.StringType},
+	},
+		cadence.NewUInt64(4),
+		cadence.NewUInt64(1),
+		cadence.NewUInt64(2),
+		cadence.NewUInt64(3),
+		cadence.NewOptional(cadence.NewUInt64(100)),
+		cadence.String("RARE"),
+		cadence.String("Ruby"),
+	)
+}
+
+func TestTypeID(t *testing.T) {
+	typeID, err := TypeID(testEnv, "AllDay.EditionCreated")
+	require.NoError(t, err)
+	assert.Equal(t, "A.e4cf4bdc1751c65d.AllDay.EditionCreated", typeID)
+
+	typeID, err = TypeIDOf(testEnv, MomentNFTDestroyed{})
+	require.NoError(t, err)
+	assert.Equal(t, "A.e4cf4bdc1751c65d.AllDay.NFT.ResourceDestroyed", typeID)
+
+	_, err = TypeID(testEnv, "TopShot.Deposit")
+	assert.EqualError(t, err, `events: unknown contract "TopShot" in event "TopShot.Deposit"`)
+
+	_, err = TypeID(templates.Environment{Network: "testing"}, "AllDay.Deposit")
+	assert.EqualError(t, err, `events: no AllDay address in the "testing" environment`)
+}
+
+func TestNamesAreRegistered(t *testing.T) {
+	assert.Contains(t, Names(), "AllDay.BadgeAddedToEntity")
+	assert.Contains(t, Names(), "AllDay.NFT.ResourceDestroyed")
+	assert.Contains(t, Names(), "PackNFT.Revealed")
+
+	for _, typeID := range NewDecoder(testEnv).TypeIDs() {
+		assert.Regexp(t, `^A\.e4cf4bdc1751c65d\.(AllDay|PackNFT)\.`, typeID)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	decoder := NewDecoder(testEnv)
+
+	event, err := decoder.Decode(flow.Event{
+		Type:  "A.e4cf4bdc1751c65d.AllDay.EditionCreated",
+		Value: editionCreated("e4cf4bdc1751c65d"),
+	})
+	require.NoError(t, err)
+	maxMintSize := uint64(100)
+	assert.Equal(t, EditionCreated{
+		ID:          4,
+		SeriesID:    1,
+		SetID:       2,
+		PlayID:      3,
+		MaxMintSize: &maxMintSize,
+		Tier:        model.TierRare,
+		Parallel:    model.ParallelRuby,
+	}, event)
+
+	payload, err := ccf.Encode(eventValue("e4cf4bdc1751c65d", "AllDay.NFT.ResourceDestroyed", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "editionID", Type: cadence.UInt64Type},
+		{Identifier: "serialNumber", Type: cadence.UInt64Type},
+		{Identifier: "mintingDate", Type: cadence.UFix64Type},
+	},
+		cadence.NewUInt64(7),
+		cadence.NewUInt64(4),
+		cadence.NewUInt64(1),
+		cadence.UFix64(1_700_000_000*100_000_000),
+	))
+	require.NoError(t, err)
+	event, err = decoder.Decode(flow.Event{Payload: payload})
+	require.NoError(t, err)
+	assert.Equal(t, MomentNFTDestroyed{
+		ID:           7,
+		EditionID:    4,
+		SerialNumber: 1,
+		MintingDate:  time.Unix(1_700_000_000, 0).UTC(),
+	}, event)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	decoder := NewDecoder(testEnv)
+
+	// Same contract and event name on another account
+	_, err := decoder.DecodeValue(editionCreated("01cf0e2f2f715450"))
+	assert.ErrorIs(t, err, ErrUnknownEvent)
+	assert.EqualError(t, err, "events: unknown event A.01cf0e2f2f715450.AllDay.EditionCreated")
+
+	_, err = decoder.DecodeValue(eventValue("e4cf4bdc1751c65d", "AllDay.SeriesClosed", []cadence.Field{
+		{Identifier: "id", Type: cadence.StringType},
+	}, cadence.String("1")))
+	assert.EqualError(t, err, "events: decode AllDay.SeriesClosed: codec: cannot decode String into uint64 (at id)")
+}
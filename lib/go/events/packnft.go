@@ -0,0 +1,200 @@
+package events
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	register[PackContractInitialized]()
+	register[PackWithdraw]()
+	register[PackDeposit]()
+	register[PackRevealRequest]()
+	register[PackOpenRequest]()
+	register[PackRevealed]()
+	register[PackOpened]()
+	register[PackMint]()
+	register[PackDestroyed]()
+}
+
+// ------------------------------------------------------------
+// Contract
+// ------------------------------------------------------------
+
+// PackContractInitialized is emitted when the PackNFT contract is deployed
+type PackContractInitialized struct{}
+
+func (PackContractInitialized) EventName() string { return "PackNFT.ContractInitialized" }
+
+// ------------------------------------------------------------
+// Collection
+// ------------------------------------------------------------
+
+// PackWithdraw is emitted when a pack is withdrawn from a collection
+type PackWithdraw struct {
+	ID uint64 `cadence:"id"`
+	// From is nil if the collection is not stored in an account
+	From *flow.Address `cadence:"from"`
+}
+
+func (PackWithdraw) EventName() string { return "PackNFT.Withdraw" }
+
+// PackDeposit is emitted when a pack is deposited into a collection
+type PackDeposit struct {
+	ID uint64 `cadence:"id"`
+	// To is nil if the collection is not stored in an account
+	To *flow.Address `cadence:"to"`
+}
+
+func (PackDeposit) EventName() string { return "PackNFT.Deposit" }
+
+// ------------------------------------------------------------
+// Packs
+// ------------------------------------------------------------
+
+// PackMint is emitted when an operator mints a sealed pack
+type PackMint struct {
+	ID uint64 `cadence:"id"`
+	// CommitHash is the hex encoded SHA2-256 hash of the pack's salt and
+	// contents, see CommitHash
+	CommitHash string `cadence:"commitHash"`
+	DistID     uint64 `cadence:"distId"`
+}
+
+func (PackMint) EventName() string { return "PackNFT.Mint" }
+
+// PackRevealRequest is emitted when the owner of a sealed pack asks for it to
+// be revealed, and opened as well if OpenRequest is true
+type PackRevealRequest struct {
+	ID          uint64 `cadence:"id"`
+	OpenRequest bool   `cadence:"openRequest"`
+}
+
+func (PackRevealRequest) EventName() string { return "PackNFT.RevealRequest" }
+
+// PackOpenRequest is emitted when the owner of a revealed pack asks for it to
+// be opened
+type PackOpenRequest struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (PackOpenRequest) EventName() string { return "PackNFT.OpenRequest" }
+
+// PackRevealed is emitted when a pack's contents are revealed
+type PackRevealed struct {
+	ID   uint64 `cadence:"id"`
+	Salt string `cadence:"salt"`
+	// NFTs is the comma separated hash strings of the pack's collectibles,
+	// see ParseCollectibles
+	NFTs string `cadence:"nfts"`
+}
+
+func (PackRevealed) EventName() string { return "PackNFT.Revealed" }
+
+// Collectibles parses the revealed contents of the pack
+func (e PackRevealed) Collectibles() ([]Collectible, error) {
+	return ParseCollectibles(e.NFTs)
+}
+
+// Verify is true if the revealed contents match the commit hash the pack was
+// minted with
+func (e PackRevealed) Verify(commitHash string) bool {
+	return strings.EqualFold(CommitHash(e.Salt, e.NFTs), commitHash)
+}
+
+// PackOpened is emitted when a revealed pack is opened and its contents are
+// sent to the owner
+type PackOpened struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (PackOpened) EventName() string { return "PackNFT.Opened" }
+
+// PackDestroyed is the ResourceDestroyed event emitted when a pack is destroyed
+type PackDestroyed struct {
+	ID uint64 `cadence:"id"`
+}
+
+func (PackDestroyed) EventName() string { return "PackNFT.NFT.ResourceDestroyed" }
+
+// ------------------------------------------------------------
+// Collectibles
+// ------------------------------------------------------------
+
+// Collectible is an NFT in a pack, an IPackNFT.Collectible
+type Collectible struct {
+	Address      flow.Address
+	ContractName string
+	ID           uint64
+}
+
+// Type is the type identifier of the collectible's contract, such as
+// A.e4cf4bdc1751c65d.AllDay
+func (c Collectible) Type() string {
+	return fmt.Sprintf("A.%s.%s", c.Address.Hex(), c.ContractName)
+}
+
+func (c Collectible) String() string {
+	return fmt.Sprintf("%s.%d", c.Type(), c.ID)
+}
+
+// ParseCollectibles parses the contents of a revealed pack, which are the
+// hash strings of its collectibles joined by commas:
+//
+//	A.0xe4cf4bdc1751c65d.AllDay.1,A.0xe4cf4bdc1751c65d.AllDay.2
+//
+// Addresses are accepted with and without the 0x prefix.
+func ParseCollectibles(nfts string) ([]Collectible, error) {
+	if nfts == "" {
+		return nil, nil
+	}
+
+	entries := strings.Split(nfts, ",")
+	collectibles := make([]Collectible, len(entries))
+	for i, entry := range entries {
+		collectible, err := parseCollectible(strings.TrimSpace(entry))
+		if err != nil {
+			return nil, fmt.Errorf("events: parse collectible %d: %w", i, err)
+		}
+		collectibles[i] = collectible
+	}
+	return collectibles, nil
+}
+
+func parseCollectible(entry string) (Collectible, error) {
+	parts := strings.Split(entry, ".")
+	if len(parts) != 4 || parts[0] != "A" || parts[2] == "" {
+		return Collectible{}, fmt.Errorf("expected A.<address>.<contract>.<id>, got %q", entry)
+	}
+
+	address := strings.TrimPrefix(parts[1], "0x")
+	if len(address) == 0 || len(address) > 2*flow.AddressLength {
+		return Collectible{}, fmt.Errorf("invalid address %q in %q", parts[1], entry)
+	}
+	if _, err := hex.DecodeString(strings.Repeat("0", len(address)%2) + address); err != nil {
+		return Collectible{}, fmt.Errorf("invalid address %q in %q", parts[1], entry)
+	}
+
+	id, err := strconv.ParseUint(parts[3], 10, 64)
+	if err != nil {
+		return Collectible{}, fmt.Errorf("invalid ID %q in %q", parts[3], entry)
+	}
+
+	return Collectible{
+		Address:      flow.HexToAddress(address),
+		ContractName: parts[2],
+		ID:           id,
+	}, nil
+}
+
+// CommitHash returns the hex encoded SHA2-256 hash PackNFT commits a pack's
+// contents to when it is minted, given its salt and contents
+func CommitHash(salt, nfts string) string {
+	hash := sha256.Sum256([]byte(salt + "," + nfts))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const revealedNFTs = "A.0xe4cf4bdc1751c65d.AllDay.1,A.0xe4cf4bdc1751c65d.AllDay.2"
+
+func TestDecodePackRevealed(t *testing.T) {
+	event, err := NewDecoder(testEnv).DecodeValue(eventValue("e4cf4bdc1751c65d", "PackNFT.Revealed", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "salt", Type: cadence.StringType},
+		{Identifier: "nfts", Type: cadence.StringType},
+	},
+		cadence.NewUInt64(5),
+		cadence.String("0123abcd"),
+		cadence.String(revealedNFTs),
+	))
+	require.NoError(t, err)
+	revealed, ok := event.(PackRevealed)
+	require.True(t, ok)
+	assert.Equal(t, PackRevealed{ID: 5, Salt: "0123abcd", NFTs: revealedNFTs}, revealed)
+
+	collectibles, err := revealed.Collectibles()
+	require.NoError(t, err)
+	allDay := flow.HexToAddress("e4cf4bdc1751c65d")
+	assert.Equal(t, []Collectible{
+		{Address: allDay, ContractName: "AllDay", ID: 1},
+		{Address: allDay, ContractName: "AllDay", ID: 2},
+	}, collectibles)
+	assert.Equal(t, "A.e4cf4bdc1751c65d.AllDay", collectibles[0].Type())
+	assert.Equal(t, "A.e4cf4bdc1751c65d.AllDay.2", collectibles[1].String())
+
+	assert.True(t, revealed.Verify("6e086cba087e9a45a517f9b8d7752ead93f9c39a50cb7a98c240960c021a4e03"))
+	assert.True(t, revealed.Verify("6E086CBA087E9A45A517F9B8D7752EAD93F9C39A50CB7A98C240960C021A4E03"))
+	assert.False(t, PackRevealed{Salt: "other", NFTs: revealedNFTs}.Verify("6e086cba087e9a45a517f9b8d7752ead93f9c39a50cb7a98c240960c021a4e03"))
+}
+
+func TestDecodePackMint(t *testing.T) {
+	event, err := NewDecoder(testEnv).DecodeValue(eventValue("e4cf4bdc1751c65d", "PackNFT.Mint", []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "commitHash", Type: cadence.StringType},
+		{Identifier: "distId", Type: cadence.UInt64Type},
+	},
+		cadence.NewUInt64(5),
+		cadence.String("6e086cba"),
+		cadence.NewUInt64(9),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, PackMint{ID: 5, CommitHash: "6e086cba", DistID: 9}, event)
+}
+
+func TestParseCollectibles(t *testing.T) {
+	collectibles, err := ParseCollectibles("A.01.TopShot.10, A.0x0000000000000002.AllDay.18446744073709551615")
+	require.NoError(t, err)
+	assert.Equal(t, []Collectible{
+		{Address: flow.HexToAddress("01"), ContractName: "TopShot", ID: 10},
+		{Address: flow.HexToAddress("02"), ContractName: "AllDay", ID: 18446744073709551615},
+	}, collectibles)
+
+	collectibles, err = ParseCollectibles("")
+	require.NoError(t, err)
+	assert.Nil(t, collectibles)
+
+	for nfts, expected := range map[string]string{
+		"A.01.AllDay":             `events: parse collectible 0: expected A.<address>.<contract>.<id>, got "A.01.AllDay"`,
+		"A.01.AllDay.1,B.01.X.2":  `events: parse collectible 1: expected A.<address>.<contract>.<id>, got "B.01.X.2"`,
+		"A.0xzz.AllDay.1":         `events: parse collectible 0: invalid address "0xzz" in "A.0xzz.AllDay.1"`,
+		"A.01020304050607080.X.1": `events: parse collectible 0: invalid address "01020304050607080" in "A.01020304050607080.X.1"`,
+		"A.01.AllDay.-1":          `events: parse collectible 0: invalid ID "-1" in "A.01.AllDay.-1"`,
+		"A.01.AllDay.1,":          `events: parse collectible 1: expected A.<address>.<contract>.<id>, got ""`,
+	} {
+		_, err := ParseCollectibles(nfts)
+		assert.EqualError(t, err, expected, nfts)
+	}
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+)
+
+// decode decodes value into a T, naming the Cadence type in errors
+func decode[T any](typeName string, value cadence.Value) (T, error) {
+	var result T
+	if err := codec.Decode(value, &result); err != nil {
+		return result, fmt.Errorf("model: decode %s: %w", typeName, err)
+	}
+	return result, nil
+}
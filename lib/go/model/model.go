@@ -0,0 +1,185 @@
+package model
+
+import (
+	"time"
+
+	"github.com/onflow/cadence"
+)
+
+// Series is AllDay.SeriesData
+type Series struct {
+	ID     uint64 `cadence:"id" json:"id"`
+	Name   string `cadence:"name" json:"name"`
+	Active bool   `cadence:"active" json:"active"`
+}
+
+// DecodeSeries decodes an AllDay.SeriesData value
+func DecodeSeries(value cadence.Value) (Series, error) {
+	return decode[Series]("SeriesData", value)
+}
+
+// DecodeSeriesList decodes an [AllDay.SeriesData] value
+func DecodeSeriesList(value cadence.Value) ([]Series, error) {
+	return decode[[]Series]("[SeriesData]", value)
+}
+
+// Set is AllDay.SetData
+type Set struct {
+	ID   uint64 `cadence:"id" json:"id"`
+	Name string `cadence:"name" json:"name"`
+	// SetPlaysInEditions holds the IDs of the plays that are in an edition with this set
+	SetPlaysInEditions map[uint64]bool `cadence:"setPlaysInEditions" json:"setPlaysInEditions"`
+}
+
+// SetPlayExistsInEdition is true if the play is in an edition with this set
+func (s Set) SetPlayExistsInEdition(playID uint64) bool {
+	return s.SetPlaysInEditions[playID]
+}
+
+// DecodeSet decodes an AllDay.SetData value
+func DecodeSet(value cadence.Value) (Set, error) {
+	return decode[Set]("SetData", value)
+}
+
+// DecodeSets decodes an [AllDay.SetData] value
+func DecodeSets(value cadence.Value) ([]Set, error) {
+	return decode[[]Set]("[SetData]", value)
+}
+
+// Play is AllDay.PlayData
+type Play struct {
+	ID             uint64            `cadence:"id" json:"id"`
+	Classification string            `cadence:"classification" json:"classification"`
+	Metadata       map[string]string `cadence:"metadata" json:"metadata"`
+}
+
+// DecodePlay decodes an AllDay.PlayData value
+func DecodePlay(value cadence.Value) (Play, error) {
+	return decode[Play]("PlayData", value)
+}
+
+// DecodePlays decodes an [AllDay.PlayData] value
+func DecodePlays(value cadence.Value) ([]Play, error) {
+	return decode[[]Play]("[PlayData]", value)
+}
+
+// Edition is AllDay.EditionData and the edition's parallel
+type Edition struct {
+	ID       uint64 `cadence:"id" json:"id"`
+	SeriesID uint64 `cadence:"seriesID" json:"seriesID"`
+	SetID    uint64 `cadence:"setID" json:"setID"`
+	PlayID   uint64 `cadence:"playID" json:"playID"`
+	// MaxMintSize is nil if the edition can be minted without limit
+	MaxMintSize *uint64 `cadence:"maxMintSize" json:"maxMintSize"`
+	Tier        Tier    `cadence:"tier" json:"tier"`
+	NumMinted   uint64  `cadence:"numMinted" json:"numMinted"`
+	// Parallel is empty when decoded from AllDay.EditionData, which only
+	// exposes it through getParallel
+	Parallel Parallel `cadence:"parallel,optional" json:"parallel,omitempty"`
+}
+
+// MaxMintSizeReached mirrors EditionData.maxEditionMintSizeReached. Closing
+// an edition sets its max mint size to the number minted, so it is also true
+// for closed editions.
+func (e Edition) MaxMintSizeReached() bool {
+	return e.MaxMintSize != nil && e.NumMinted == *e.MaxMintSize
+}
+
+// RemainingSupply returns how many more moments can be minted in the
+// edition, or nil if it can be minted without limit
+func (e Edition) RemainingSupply() *uint64 {
+	if e.MaxMintSize == nil {
+		return nil
+	}
+	remaining := *e.MaxMintSize - e.NumMinted
+	return &remaining
+}
+
+// DecodeEdition decodes an AllDay.EditionData value, or a struct with the
+// same fields and a parallel such as the one read_edition_by_id.cdc returns
+func DecodeEdition(value cadence.Value) (Edition, error) {
+	return decode[Edition]("EditionData", value)
+}
+
+// DecodeEditions decodes an [AllDay.EditionData] value
+func DecodeEditions(value cadence.Value) ([]Edition, error) {
+	return decode[[]Edition]("[EditionData]", value)
+}
+
+// Moment is the data of an AllDay.NFT
+type Moment struct {
+	ID           uint64    `cadence:"id" json:"id"`
+	EditionID    uint64    `cadence:"editionID" json:"editionID"`
+	SerialNumber uint64    `cadence:"serialNumber" json:"serialNumber"`
+	MintingDate  time.Time `cadence:"mintingDate" json:"mintingDate"`
+}
+
+// DecodeMoment decodes the [id, editionID, serialNumber, mintingDate] array
+// read_moment_nft_properties.cdc returns, or a composite with those fields
+// such as the NFT's ResourceDestroyed event
+func DecodeMoment(value cadence.Value) (Moment, error) {
+	return decode[Moment]("NFT", value)
+}
+
+// MomentView is a moment joined with its edition, play, series, set and
+// badges, as read_moment_views.cdc returns it
+type MomentView struct {
+	ID           uint64    `cadence:"id" json:"id"`
+	SerialNumber uint64    `cadence:"serialNumber" json:"serialNumber"`
+	MintingDate  time.Time `cadence:"mintingDate" json:"mintingDate"`
+	// Edition includes the edition's parallel
+	Edition    Edition `cadence:"edition" json:"edition"`
+	Play       Play    `cadence:"play" json:"play"`
+	SeriesName string  `cadence:"seriesName" json:"seriesName"`
+	SetName    string  `cadence:"setName" json:"setName"`
+	// Badges are the badges of the moment, its edition, its play, its set and
+	// its series, merged by slug as NFT.getBadges merges them
+	Badges []Badge `cadence:"badges" json:"badges"`
+}
+
+// DecodeMomentViews decodes the [MomentView] value read_moment_views.cdc
+// returns
+func DecodeMomentViews(value cadence.Value) ([]MomentView, error) {
+	return decode[[]MomentView]("[MomentView]", value)
+}
+
+// Badge is AllDay.Badge
+type Badge struct {
+	Slug        string            `cadence:"slug" json:"slug"`
+	Title       string            `cadence:"title" json:"title"`
+	Description string            `cadence:"description" json:"description"`
+	Visible     bool              `cadence:"visible" json:"visible"`
+	SlugV2      string            `cadence:"slugV2" json:"slugV2"`
+	Metadata    map[string]string `cadence:"metadata" json:"metadata"`
+}
+
+// DecodeBadge decodes an AllDay.Badge value
+func DecodeBadge(value cadence.Value) (Badge, error) {
+	return decode[Badge]("Badge", value)
+}
+
+// DecodeOptionalBadge decodes an AllDay.Badge? value, returning nil for nil
+func DecodeOptionalBadge(value cadence.Value) (*Badge, error) {
+	return decode[*Badge]("Badge?", value)
+}
+
+// DecodeBadges decodes an [AllDay.Badge] or [AllDay.Badge]? value, returning
+// nil for nil
+func DecodeBadges(value cadence.Value) ([]Badge, error) {
+	return decode[[]Badge]("[Badge]", value)
+}
+
+// BadgeEntity is AllDay.BadgeEntity, a play, an edition, a moment, a set or
+// a series a badge is attached to
+type BadgeEntity struct {
+	// EntityType is "play", "edition", "moment", "set" or "series"
+	EntityType string `cadence:"entityType" json:"entityType"`
+	EntityID   uint64 `cadence:"entityID" json:"entityID"`
+	// Metadata is the metadata the badge was attached with
+	Metadata map[string]string `cadence:"metadata" json:"metadata"`
+}
+
+// DecodeBadgeEntities decodes an [AllDay.BadgeEntity] value
+func DecodeBadgeEntities(value cadence.Value) ([]BadgeEntity, error) {
+	return decode[[]BadgeEntity]("[BadgeEntity]", value)
+}
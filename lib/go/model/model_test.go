@@ -0,0 +1,155 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+)
+
+func structValue(name string, fields map[string]cadence.Value) cadence.Struct {
+	var typeFields []cadence.Field
+	var values []cadence.Value
+	for identifier, value := range fields {
+		typeFields = append(typeFields, cadence.Field{Identifier: identifier, Type: value.Type()})
+		values = append(values, value)
+	}
+	return cadence.NewStruct(values).WithType(cadence.NewStructType(nil, name, typeFields, nil))
+}
+
+func editionValue(maxMintSize cadence.Optional) map[string]cadence.Value {
+	return map[string]cadence.Value{
+		"id":          cadence.NewUInt64(4),
+		"seriesID":    cadence.NewUInt64(1),
+		"setID":       cadence.NewUInt64(2),
+		"playID":      cadence.NewUInt64(3),
+		"maxMintSize": maxMintSize,
+		"tier":        cadence.String("RARE"),
+		"numMinted":   cadence.NewUInt64(10),
+	}
+}
+
+func TestDecodeEdition(t *testing.T) {
+	edition, err := DecodeEdition(structValue("A.01.AllDay.EditionData", editionValue(cadence.NewOptional(nil))))
+	require.NoError(t, err)
+	assert.Equal(t, Edition{
+		ID:        4,
+		SeriesID:  1,
+		SetID:     2,
+		PlayID:    3,
+		Tier:      TierRare,
+		NumMinted: 10,
+	}, edition)
+	assert.Nil(t, edition.MaxMintSize)
+	assert.False(t, edition.MaxMintSizeReached())
+	assert.Nil(t, edition.RemainingSupply())
+
+	fields := editionValue(cadence.NewOptional(cadence.NewUInt64(10)))
+	fields["parallel"] = cadence.String("Standard")
+	edition, err = DecodeEdition(structValue("s.0.Result", fields))
+	require.NoError(t, err)
+	require.NotNil(t, edition.MaxMintSize)
+	assert.Equal(t, uint64(10), *edition.MaxMintSize)
+	assert.Equal(t, ParallelStandard, edition.Parallel)
+	assert.True(t, edition.MaxMintSizeReached())
+	assert.Equal(t, uint64(0), *edition.RemainingSupply())
+}
+
+func TestDecodeErrors(t *testing.T) {
+	fields := editionValue(cadence.NewOptional(cadence.String("10")))
+	_, err := DecodeEdition(structValue("A.01.AllDay.EditionData", fields))
+	assert.EqualError(t, err, `model: decode EditionData: codec: cannot decode String into uint64 (at maxMintSize)`)
+
+	delete(fields, "numMinted")
+	fields["maxMintSize"] = cadence.NewOptional(nil)
+	_, err = DecodeEdition(structValue("A.01.AllDay.EditionData", fields))
+	assert.EqualError(t, err, `model: decode EditionData: codec: missing field "numMinted" in A.01.AllDay.EditionData`)
+
+	_, err = DecodeSeries(cadence.String("series"))
+	assert.EqualError(t, err, `model: decode SeriesData: codec: cannot decode String into model.Series`)
+
+	_, err = DecodeSeriesList(cadence.NewArray([]cadence.Value{cadence.NewUInt64(1)}))
+	assert.EqualError(t, err, `model: decode [SeriesData]: codec: cannot decode UInt64 into model.Series (at [0])`)
+	var codecErr *codec.Error
+	require.ErrorAs(t, err, &codecErr)
+	assert.Equal(t, "[0]", codecErr.Path)
+}
+
+func TestDecodeSetAndPlay(t *testing.T) {
+	set, err := DecodeSet(structValue("A.01.AllDay.SetData", map[string]cadence.Value{
+		"id":   cadence.NewUInt64(2),
+		"name": cadence.String("Set"),
+		"setPlaysInEditions": cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.NewUInt64(3), Value: cadence.NewBool(true)},
+		}),
+	}))
+	require.NoError(t, err)
+	assert.True(t, set.SetPlayExistsInEdition(3))
+	assert.False(t, set.SetPlayExistsInEdition(4))
+
+	plays, err := DecodePlays(cadence.NewArray([]cadence.Value{
+		structValue("A.01.AllDay.PlayData", map[string]cadence.Value{
+			"id":             cadence.NewUInt64(3),
+			"classification": cadence.String("PASSING_TOUCHDOWN"),
+			"metadata": cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: cadence.String("playerFirstName"), Value: cadence.String("Alex")},
+			}),
+		}),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []Play{{ID: 3, Classification: "PASSING_TOUCHDOWN", Metadata: map[string]string{"playerFirstName": "Alex"}}}, plays)
+}
+
+func TestDecodeMoment(t *testing.T) {
+	mintingDate, err := cadence.NewUFix64("1700000000.50000000")
+	require.NoError(t, err)
+
+	moment, err := DecodeMoment(cadence.NewArray([]cadence.Value{
+		cadence.NewUInt64(7),
+		cadence.NewUInt64(4),
+		cadence.NewUInt64(1),
+		mintingDate,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, Moment{
+		ID:           7,
+		EditionID:    4,
+		SerialNumber: 1,
+		MintingDate:  time.Unix(1700000000, 500_000_000).UTC(),
+	}, moment)
+
+	_, err = DecodeMoment(cadence.NewArray([]cadence.Value{cadence.NewUInt64(7)}))
+	assert.EqualError(t, err, "model: decode NFT: codec: cannot decode 1 elements into model.Moment with 4 fields")
+}
+
+func TestDecodeBadges(t *testing.T) {
+	badgeValue := structValue("A.01.AllDay.Badge", map[string]cadence.Value{
+		"slug":        cadence.String("rookie"),
+		"title":       cadence.String("Rookie"),
+		"description": cadence.String("First season"),
+		"visible":     cadence.NewBool(true),
+		"slugV2":      cadence.String("rookie-v2"),
+		"metadata":    cadence.NewDictionary(nil),
+	})
+
+	badge, err := DecodeOptionalBadge(cadence.NewOptional(badgeValue))
+	require.NoError(t, err)
+	require.NotNil(t, badge)
+	assert.Equal(t, "rookie-v2", badge.SlugV2)
+
+	badge, err = DecodeOptionalBadge(cadence.NewOptional(nil))
+	require.NoError(t, err)
+	assert.Nil(t, badge)
+
+	badges, err := DecodeBadges(cadence.NewOptional(cadence.NewArray([]cadence.Value{badgeValue})))
+	require.NoError(t, err)
+	assert.Len(t, badges, 1)
+
+	badges, err = DecodeBadges(cadence.NewOptional(nil))
+	require.NoError(t, err)
+	assert.Nil(t, badges)
+}
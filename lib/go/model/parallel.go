@@ -0,0 +1,111 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parallel is the parallel of an edition. Editions are created either without
+// a parallel or with one listed in AllDay.isValidParallel, and the contract
+// reports editions without one as ParallelStandard.
+type Parallel string
+
+const (
+	ParallelStandard Parallel = "Standard"
+	ParallelRuby     Parallel = "Ruby"
+	ParallelEmerald  Parallel = "Emerald"
+	ParallelSapphire Parallel = "Sapphire"
+	ParallelOpal     Parallel = "Opal"
+	ParallelDiamond  Parallel = "Diamond"
+	ParallelObsidian Parallel = "Obsidian"
+)
+
+// parallels is every parallel an edition can be created with, from most to
+// least common
+var parallels = []Parallel{
+	ParallelRuby,
+	ParallelEmerald,
+	ParallelSapphire,
+	ParallelOpal,
+	ParallelDiamond,
+	ParallelObsidian,
+}
+
+// Parallels returns every parallel an edition can be created with, from most
+// to least common. It does not include ParallelStandard.
+func Parallels() []Parallel {
+	return append([]Parallel(nil), parallels...)
+}
+
+// ParseParallel returns the parallel named s, ignoring case. It accepts
+// "Standard" as returned by the contract.
+func ParseParallel(s string) (Parallel, error) {
+	if strings.EqualFold(s, string(ParallelStandard)) {
+		return ParallelStandard, nil
+	}
+	for _, parallel := range parallels {
+		if strings.EqualFold(s, string(parallel)) {
+			return parallel, nil
+		}
+	}
+	return "", fmt.Errorf("model: invalid parallel %q, expected %s or one of %v", s, ParallelStandard, parallels)
+}
+
+// IsValid is true if p is ParallelStandard or one of Parallels
+func (p Parallel) IsValid() bool {
+	return p.Rarity() >= 0
+}
+
+// IsStandard is true if p is the parallel of editions created without one
+func (p Parallel) IsStandard() bool {
+	return p == ParallelStandard
+}
+
+// Rarity returns 0 for ParallelStandard, the position of p in Parallels plus
+// one for the others, or -1 if p is not valid
+func (p Parallel) Rarity() int {
+	if p == ParallelStandard {
+		return 0
+	}
+	for i, parallel := range parallels {
+		if p == parallel {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// Compare returns -1, 0 or +1 depending on whether p is more common, as
+// rare, or rarer than other. Invalid parallels sort before valid ones.
+func (p Parallel) Compare(other Parallel) int {
+	return compareRarity(p.Rarity(), other.Rarity())
+}
+
+// Optional returns nil for ParallelStandard and p otherwise, which is what
+// createEdition expects
+func (p Parallel) Optional() *Parallel {
+	if p == ParallelStandard {
+		return nil
+	}
+	return &p
+}
+
+func (p Parallel) String() string {
+	return string(p)
+}
+
+func (p Parallel) MarshalText() ([]byte, error) {
+	if !p.IsValid() {
+		return nil, fmt.Errorf("model: invalid parallel %q", string(p))
+	}
+	return []byte(p), nil
+}
+
+func (p *Parallel) UnmarshalText(text []byte) error {
+	parallel, err := ParseParallel(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parallel
+	return nil
+}
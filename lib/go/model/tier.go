@@ -0,0 +1,96 @@
+// Package model holds Go representations of the values the AllDay contract
+// stores and returns.
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tier is the rarity tier of an edition. The contract only accepts the tiers
+// listed in AllDay.isValidTier.
+type Tier string
+
+const (
+	TierCommon    Tier = "COMMON"
+	TierUncommon  Tier = "UNCOMMON"
+	TierRare      Tier = "RARE"
+	TierLegendary Tier = "LEGENDARY"
+	TierUltimate  Tier = "ULTIMATE"
+)
+
+// tiers is every valid tier, from most to least common
+var tiers = []Tier{
+	TierCommon,
+	TierUncommon,
+	TierRare,
+	TierLegendary,
+	TierUltimate,
+}
+
+// Tiers returns every valid tier, from most to least common
+func Tiers() []Tier {
+	return append([]Tier(nil), tiers...)
+}
+
+// ParseTier returns the tier named s, ignoring case
+func ParseTier(s string) (Tier, error) {
+	for _, tier := range tiers {
+		if strings.EqualFold(s, string(tier)) {
+			return tier, nil
+		}
+	}
+	return "", fmt.Errorf("model: invalid tier %q, expected one of %v", s, tiers)
+}
+
+// IsValid is true if the contract accepts t
+func (t Tier) IsValid() bool {
+	return t.Rarity() >= 0
+}
+
+// Rarity returns the position of t in Tiers, or -1 if t is not valid
+func (t Tier) Rarity() int {
+	for i, tier := range tiers {
+		if t == tier {
+			return i
+		}
+	}
+	return -1
+}
+
+// Compare returns -1, 0 or +1 depending on whether t is more common, as
+// rare, or rarer than other. Invalid tiers sort before valid ones.
+func (t Tier) Compare(other Tier) int {
+	return compareRarity(t.Rarity(), other.Rarity())
+}
+
+func (t Tier) String() string {
+	return string(t)
+}
+
+func (t Tier) MarshalText() ([]byte, error) {
+	if !t.IsValid() {
+		return nil, fmt.Errorf("model: invalid tier %q", string(t))
+	}
+	return []byte(t), nil
+}
+
+func (t *Tier) UnmarshalText(text []byte) error {
+	tier, err := ParseTier(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tier
+	return nil
+}
+
+func compareRarity(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nfl "github.com/dapperlabs/nfl-smart-contracts"
+)
+
+// contractDictionaryKeys returns the keys of the dictionary literal in the
+// body of the named AllDay function
+func contractDictionaryKeys(t *testing.T, function string) []string {
+	body := regexp.MustCompile(`fun ` + function + `\([^)]*\): Bool \{[^}]*\{([^}]*)\}`).FindSubmatch(nfl.AllDayContract)
+	require.NotNil(t, body, "AllDay.%s not found", function)
+
+	var keys []string
+	for _, match := range regexp.MustCompile(`"(\w+)":\s*true`).FindAllSubmatch(body[1], -1) {
+		keys = append(keys, string(match[1]))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestTiersMatchContract(t *testing.T) {
+	var names []string
+	for _, tier := range Tiers() {
+		names = append(names, string(tier))
+	}
+	sort.Strings(names)
+	assert.Equal(t, contractDictionaryKeys(t, "isValidTier"), names)
+}
+
+func TestParallelsMatchContract(t *testing.T) {
+	var names []string
+	for _, parallel := range Parallels() {
+		names = append(names, string(parallel))
+	}
+	sort.Strings(names)
+	assert.Equal(t, contractDictionaryKeys(t, "isValidParallel"), names)
+	assert.Contains(t, string(nfl.AllDayContract), `return "`+string(ParallelStandard)+`"`)
+}
+
+func TestParseTier(t *testing.T) {
+	tier, err := ParseTier("legendary")
+	require.NoError(t, err)
+	assert.Equal(t, TierLegendary, tier)
+
+	_, err = ParseTier("EPIC")
+	assert.EqualError(t, err, `model: invalid tier "EPIC", expected one of [COMMON UNCOMMON RARE LEGENDARY ULTIMATE]`)
+	assert.False(t, Tier("common").IsValid())
+}
+
+func TestParseParallel(t *testing.T) {
+	parallel, err := ParseParallel("standard")
+	require.NoError(t, err)
+	assert.Equal(t, ParallelStandard, parallel)
+	assert.Nil(t, parallel.Optional())
+
+	parallel, err = ParseParallel("OPAL")
+	require.NoError(t, err)
+	assert.Equal(t, ParallelOpal, parallel)
+	assert.Equal(t, ParallelOpal, *parallel.Optional())
+
+	_, err = ParseParallel("Gold")
+	assert.Error(t, err)
+}
+
+func TestRarityOrdering(t *testing.T) {
+	assert.Equal(t, -1, TierCommon.Compare(TierRare))
+	assert.Equal(t, 1, TierUltimate.Compare(TierLegendary))
+	assert.Equal(t, 0, TierRare.Compare(TierRare))
+	assert.Equal(t, -1, Tier("EPIC").Compare(TierCommon))
+
+	assert.Equal(t, -1, ParallelStandard.Compare(ParallelRuby))
+	assert.Equal(t, 1, ParallelObsidian.Compare(ParallelDiamond))
+}
+
+func TestTierParallelJSON(t *testing.T) {
+	type edition struct {
+		Tier     Tier     `json:"tier"`
+		Parallel Parallel `json:"parallel"`
+	}
+
+	data, err := json.Marshal(edition{Tier: TierRare, Parallel: ParallelEmerald})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tier": "RARE", "parallel": "Emerald"}`, string(data))
+
+	var decoded edition
+	require.NoError(t, json.Unmarshal([]byte(`{"tier": "rare", "parallel": "Standard"}`), &decoded))
+	assert.Equal(t, edition{Tier: TierRare, Parallel: ParallelStandard}, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"tier": "EPIC"}`), &decoded))
+	_, err = json.Marshal(edition{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package render
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+// badgeLevels are the entity types NFT.getBadges merges, from the moment up
+// to its series
+var badgeLevels = []string{"moment", "edition", "play", "set", "series"}
+
+// BadgeAttachment is a badge attached to a play, an edition, a moment, a set
+// or a series, with the metadata it was attached with
+type BadgeAttachment struct {
+	Slug       string
+	EntityType string
+	EntityID   uint64
+	Metadata   map[string]string
+}
+
+// BadgeIndex mirrors the badges and attachments of AllDay.AddOns. Build it
+// from badges and attachments read through scripts, or from the badge events
+// in the order they were emitted:
+//
+//	index := render.NewBadgeIndex()
+//	for _, event := range badgeEvents {
+//		index.Apply(event)
+//	}
+//	moment.Badges = index.MomentBadges(moment.ID, moment.Edition)
+type BadgeIndex struct {
+	badges map[string]model.Badge
+	// attachments holds the metadata of each slug attached to an entity, by
+	// entity type and ID
+	attachments map[string]map[uint64]map[string]map[string]string
+}
+
+// NewBadgeIndex returns an empty BadgeIndex
+func NewBadgeIndex() *BadgeIndex {
+	return &BadgeIndex{
+		badges:      map[string]model.Badge{},
+		attachments: map[string]map[uint64]map[string]map[string]string{},
+	}
+}
+
+// PutBadge creates or replaces the badge with badge.Slug
+func (i *BadgeIndex) PutBadge(badge model.Badge) {
+	i.badges[badge.Slug] = badge
+}
+
+// DeleteBadge deletes the badge with slug. Like AddOns.deleteBadge, it keeps
+// the badge's attachments, which resolve again if the slug is created again.
+func (i *BadgeIndex) DeleteBadge(slug string) {
+	delete(i.badges, slug)
+}
+
+// Attach attaches the badge with slug to an entity with metadata, replacing
+// an earlier attachment of the slug to the entity
+func (i *BadgeIndex) Attach(slug, entityType string, entityID uint64, metadata map[string]string) {
+	entities, ok := i.attachments[entityType]
+	if !ok {
+		entities = map[uint64]map[string]map[string]string{}
+		i.attachments[entityType] = entities
+	}
+	slugs, ok := entities[entityID]
+	if !ok {
+		slugs = map[string]map[string]string{}
+		entities[entityID] = slugs
+	}
+	slugs[slug] = metadata
+}
+
+// Detach removes the badge with slug from an entity
+func (i *BadgeIndex) Detach(slug, entityType string, entityID uint64) {
+	delete(i.attachments[entityType][entityID], slug)
+}
+
+// Apply applies a decoded badge event to the index. It ignores every other
+// event.
+func (i *BadgeIndex) Apply(event events.Event) {
+	switch event := event.(type) {
+	case events.BadgeCreated:
+		i.PutBadge(model.Badge{Slug: event.Slug, Title: event.Title, Description: event.Description, Visible: event.Visible, SlugV2: event.SlugV2, Metadata: event.Metadata})
+	case events.BadgeUpdated:
+		i.PutBadge(model.Badge{Slug: event.Slug, Title: event.Title, Description: event.Description, Visible: event.Visible, SlugV2: event.SlugV2, Metadata: event.Metadata})
+	case events.BadgeDeleted:
+		i.DeleteBadge(event.Slug)
+	case events.BadgeAddedToEntity:
+		i.Attach(event.BadgeSlug, event.EntityType, event.EntityID, event.Metadata)
+	case events.BadgeRemovedFromEntity:
+		i.Detach(event.BadgeSlug, event.EntityType, event.EntityID)
+	}
+}
+
+// Badge returns the badge with slug, or nil if there is none
+func (i *BadgeIndex) Badge(slug string) *model.Badge {
+	badge, ok := i.badges[slug]
+	if !ok {
+		return nil
+	}
+	return &badge
+}
+
+// MomentAttachments returns the attachments of each of the badges of a
+// moment of edition, by slug, from the moment's own up to its series'.
+// Attachments of deleted badges are skipped.
+func (i *BadgeIndex) MomentAttachments(momentID uint64, edition model.Edition) map[string][]BadgeAttachment {
+	ids := map[string]uint64{
+		"moment":  momentID,
+		"edition": edition.ID,
+		"play":    edition.PlayID,
+		"set":     edition.SetID,
+		"series":  edition.SeriesID,
+	}
+	attachments := map[string][]BadgeAttachment{}
+	for _, entityType := range badgeLevels {
+		for slug, metadata := range i.attachments[entityType][ids[entityType]] {
+			if _, ok := i.badges[slug]; ok {
+				attachments[slug] = append(attachments[slug], BadgeAttachment{Slug: slug, EntityType: entityType, EntityID: ids[entityType], Metadata: metadata})
+			}
+		}
+	}
+	return attachments
+}
+
+// MomentBadges mirrors NFT.getBadges. It returns the badges of a moment of
+// edition merged with its edition's, play's, set's and series' by slug and
+// sorted by slug, or nil if it has none.
+func (i *BadgeIndex) MomentBadges(momentID uint64, edition model.Edition) []model.Badge {
+	var badges []model.Badge
+	for _, slug := range slices.Sorted(maps.Keys(i.MomentAttachments(momentID, edition))) {
+		badges = append(badges, i.badges[slug])
+	}
+	return badges
+}
+
+// sortBadges returns badges sorted by slug
+func sortBadges(badges []model.Badge) []model.Badge {
+	badges = slices.Clone(badges)
+	slices.SortFunc(badges, func(a, b model.Badge) int { return strings.Compare(a.Slug, b.Slug) })
+	return badges
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+func TestBadgeIndex(t *testing.T) {
+	index := NewBadgeIndex()
+	for _, event := range []events.Event{
+		events.BadgeCreated{Slug: "rookie", Title: "Rookie", Metadata: map[string]string{}},
+		events.BadgeCreated{Slug: "mvp", Title: "MVP", Metadata: map[string]string{}},
+		events.BadgeCreated{Slug: "retired", Title: "Retired", Metadata: map[string]string{}},
+		events.BadgeCreated{Slug: "playoffs", Title: "Playoffs", Metadata: map[string]string{}},
+		events.BadgeUpdated{Slug: "rookie", Title: "First Season", Visible: true, Metadata: map[string]string{"year": "2021"}},
+		events.BadgeAddedToEntity{BadgeSlug: "rookie", EntityType: "moment", EntityID: 1, Metadata: map[string]string{"from": "moment"}},
+		events.BadgeAddedToEntity{BadgeSlug: "rookie", EntityType: "edition", EntityID: 2, Metadata: map[string]string{"from": "edition"}},
+		events.BadgeAddedToEntity{BadgeSlug: "rookie", EntityType: "play", EntityID: 3, Metadata: map[string]string{"from": "play"}},
+		events.BadgeAddedToEntity{BadgeSlug: "mvp", EntityType: "moment", EntityID: 1, Metadata: map[string]string{}},
+		events.BadgeAddedToEntity{BadgeSlug: "mvp", EntityType: "edition", EntityID: 2, Metadata: map[string]string{}},
+		events.BadgeAddedToEntity{BadgeSlug: "retired", EntityType: "play", EntityID: 3, Metadata: map[string]string{}},
+		events.BadgeAddedToEntity{BadgeSlug: "playoffs", EntityType: "set", EntityID: 4, Metadata: map[string]string{"from": "set"}},
+		events.BadgeAddedToEntity{BadgeSlug: "playoffs", EntityType: "series", EntityID: 5, Metadata: map[string]string{"from": "series"}},
+		events.BadgeAddedToEntity{BadgeSlug: "playoffs", EntityType: "play", EntityID: 3, Metadata: map[string]string{"from": "play"}},
+		events.BadgeRemovedFromEntity{BadgeSlug: "mvp", EntityType: "edition", EntityID: 2},
+		events.BadgeDeleted{Slug: "retired"},
+		events.EditionCreated{ID: 2},
+	} {
+		index.Apply(event)
+	}
+
+	rookie := model.Badge{Slug: "rookie", Title: "First Season", Visible: true, Metadata: map[string]string{"year": "2021"}}
+	mvp := model.Badge{Slug: "mvp", Title: "MVP", Metadata: map[string]string{}}
+	playoffs := model.Badge{Slug: "playoffs", Title: "Playoffs", Metadata: map[string]string{}}
+	assert.Equal(t, &rookie, index.Badge("rookie"))
+	assert.Nil(t, index.Badge("retired"))
+
+	edition := model.Edition{ID: 2, SeriesID: 5, SetID: 4, PlayID: 3}
+	other := model.Edition{ID: 6, SeriesID: 7, SetID: 8, PlayID: 9}
+
+	t.Run("Should merge badges of every level by slug", func(t *testing.T) {
+		assert.Equal(t, []model.Badge{mvp, playoffs, rookie}, index.MomentBadges(1, edition))
+		assert.Equal(t, []model.Badge{playoffs, rookie}, index.MomentBadges(4, edition))
+		assert.Nil(t, index.MomentBadges(4, other))
+	})
+
+	t.Run("Should inherit set and series badges", func(t *testing.T) {
+		assert.Equal(t, []model.Badge{playoffs}, index.MomentBadges(4, model.Edition{ID: 6, SeriesID: 7, SetID: 4, PlayID: 9}))
+		assert.Equal(t, []model.Badge{playoffs}, index.MomentBadges(4, model.Edition{ID: 6, SeriesID: 5, SetID: 8, PlayID: 9}))
+	})
+
+	t.Run("Should list the attachments of each slug from the moment up to the series", func(t *testing.T) {
+		assert.Equal(t, map[string][]BadgeAttachment{
+			"rookie": {
+				{Slug: "rookie", EntityType: "moment", EntityID: 1, Metadata: map[string]string{"from": "moment"}},
+				{Slug: "rookie", EntityType: "edition", EntityID: 2, Metadata: map[string]string{"from": "edition"}},
+				{Slug: "rookie", EntityType: "play", EntityID: 3, Metadata: map[string]string{"from": "play"}},
+			},
+			"mvp": {
+				{Slug: "mvp", EntityType: "moment", EntityID: 1, Metadata: map[string]string{}},
+			},
+			"playoffs": {
+				{Slug: "playoffs", EntityType: "play", EntityID: 3, Metadata: map[string]string{"from": "play"}},
+				{Slug: "playoffs", EntityType: "set", EntityID: 4, Metadata: map[string]string{"from": "set"}},
+				{Slug: "playoffs", EntityType: "series", EntityID: 5, Metadata: map[string]string{"from": "series"}},
+			},
+		}, index.MomentAttachments(1, edition))
+
+		edition := model.Edition{ID: 6, SeriesID: 7, SetID: 4, PlayID: 9}
+		assert.Equal(t, map[string][]BadgeAttachment{
+			"playoffs": {{Slug: "playoffs", EntityType: "set", EntityID: 4, Metadata: map[string]string{"from": "set"}}},
+		}, index.MomentAttachments(4, edition))
+	})
+
+	t.Run("Should resolve attachments of a badge created again", func(t *testing.T) {
+		assert.NotContains(t, index.MomentAttachments(1, edition), "retired")
+		index.Apply(events.BadgeCreated{Slug: "retired", Title: "Retired", Metadata: map[string]string{}})
+		assert.Contains(t, index.MomentAttachments(1, edition), "retired")
+	})
+}
@@ -0,0 +1,248 @@
+// Package render builds the MetadataViews an AllDay moment resolves from its
+// catalog data, without a script call per moment. Each function mirrors the
+// AllDay.NFT function of the same name, so names, descriptions and media URLs
+// match what resolveView returns:
+//
+//	renderer := render.NewRenderer(env)
+//	moment := render.MomentFromView(view)
+//	metadata := renderer.MomentMetadata(moment)
+package render
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/views"
+)
+
+const (
+	mediaURL  = "https://media.nflallday.com/editions/"
+	momentURL = "https://nflallday.com/moments/"
+)
+
+// Moment is an AllDay.NFT with the edition, play, series and set it was
+// minted from
+type Moment struct {
+	ID           uint64
+	SerialNumber uint64
+	Edition      model.Edition
+	Play         model.Play
+	Series       model.Series
+	Set          model.Set
+	// Badges are the moment's badges merged with its edition's, play's, set's
+	// and series', as NFT.getBadges returns them
+	Badges []model.Badge
+}
+
+// MomentFromView returns the moment a model.MomentView holds. Its series and
+// set have only their IDs and names.
+func MomentFromView(view model.MomentView) Moment {
+	return Moment{
+		ID:           view.ID,
+		SerialNumber: view.SerialNumber,
+		Edition:      view.Edition,
+		Play:         view.Play,
+		Series:       model.Series{ID: view.Edition.SeriesID, Name: view.SeriesName},
+		Set:          model.Set{ID: view.Edition.SetID, Name: view.SetName},
+		Badges:       view.Badges,
+	}
+}
+
+// Name mirrors NFT.getName
+func (m Moment) Name() string {
+	metadata := m.Play.Metadata
+	return metadata["playerFirstName"] + " " + metadata["playerLastName"] + " " + metadata["playType"]
+}
+
+// Description mirrors NFT.getDescription. It is the play's description, or
+// the series, set and serial number if the play has none.
+func (m Moment) Description() string {
+	if description := m.Play.Metadata["description"]; description != "" {
+		return description
+	}
+	return m.Series.Name + " " + m.Set.Name + " moment with serial number " + strconv.FormatUint(m.SerialNumber, 10)
+}
+
+// AssetPath mirrors NFT.assetPath
+func (m Moment) AssetPath() string {
+	return mediaURL + strconv.FormatUint(m.Edition.ID, 10) + "/media/"
+}
+
+// Image mirrors NFT.getImage
+func (m Moment) Image(imageType, format string, width int) string {
+	return m.AssetPath() + imageType + "?format=" + format + "&width=" + strconv.Itoa(width)
+}
+
+// Video mirrors NFT.getVideo
+func (m Moment) Video(videoType string) string {
+	return m.AssetPath() + videoType
+}
+
+// MomentURL mirrors NFT.getMomentURL
+func (m Moment) MomentURL() string {
+	return momentURL + strconv.FormatUint(m.ID, 10)
+}
+
+// EditionInfo mirrors NFT.getEditionInfo
+func (m Moment) EditionInfo() views.Edition {
+	name := m.Set.Name + ": #" + strconv.FormatUint(m.Edition.PlayID, 10)
+	return views.Edition{Name: &name, Number: m.SerialNumber, Max: m.Edition.MaxMintSize}
+}
+
+// TraitMap mirrors NFT.getTraits. Values have the Go types
+// views.DecodeMomentMetadata decodes them to: uint64 IDs and serial numbers,
+// strings, and the badges as a []model.Badge sorted by slug. The play's
+// non-empty metadata replaces traits of the same name.
+func (m Moment) TraitMap() map[string]any {
+	parallel := m.Edition.Parallel
+	if parallel == "" {
+		parallel = model.ParallelStandard
+	}
+	traits := map[string]any{
+		"editionID":    m.Edition.ID,
+		"editionTier":  string(m.Edition.Tier),
+		"parallel":     string(parallel),
+		"seriesName":   m.Series.Name,
+		"setName":      m.Set.Name,
+		"serialNumber": m.SerialNumber,
+	}
+	if len(m.Badges) > 0 {
+		traits["badges"] = sortBadges(m.Badges)
+	}
+	for name, value := range m.Play.Metadata {
+		if value != "" {
+			traits[name] = value
+		}
+	}
+	return traits
+}
+
+// Traits is the MetadataViews.Traits the moment resolves
+func (m Moment) Traits() views.Traits {
+	return DictToTraits(m.TraitMap(), nil)
+}
+
+// DictToTraits mirrors MetadataViews.dictToTraits. Cadence does not order a
+// dictionary's keys, so the traits are sorted by name instead.
+func DictToTraits(dict map[string]any, excludedNames []string) views.Traits {
+	traits := []views.Trait{}
+	for name, value := range dict {
+		if !slices.Contains(excludedNames, name) {
+			traits = append(traits, views.Trait{Name: name, Value: value})
+		}
+	}
+	slices.SortFunc(traits, func(a, b views.Trait) int { return strings.Compare(a.Name, b.Name) })
+	return views.Traits{Traits: traits}
+}
+
+// Display is the MetadataViews.Display the moment resolves
+func (m Moment) Display() views.Display {
+	return views.Display{
+		Name:        m.Name(),
+		Description: m.Description(),
+		Thumbnail:   views.File{URL: m.Image("image", "jpeg", 256)},
+	}
+}
+
+// Editions is the MetadataViews.Editions the moment resolves
+func (m Moment) Editions() views.Editions {
+	return views.Editions{InfoList: []views.Edition{m.EditionInfo()}}
+}
+
+// ExternalURL is the MetadataViews.ExternalURL the moment resolves
+func (m Moment) ExternalURL() views.ExternalURL {
+	return views.ExternalURL{URL: m.MomentURL()}
+}
+
+// Medias is the MetadataViews.Medias the moment resolves: its images, then
+// its videos
+func (m Moment) Medias() views.Medias {
+	var items []views.Media
+	for _, imageType := range []string{"image", "image-details", "image-logo", "image-legal", "image-player", "image-scores"} {
+		items = append(items, views.Media{File: views.File{URL: m.Image(imageType, "jpeg", 512)}, MediaType: "image/jpeg"})
+	}
+	for _, videoType := range []string{"video", "video-idle"} {
+		items = append(items, views.Media{File: views.File{URL: m.Video(videoType)}, MediaType: "video/mp4"})
+	}
+	return views.Medias{Items: items}
+}
+
+// Serial is the MetadataViews.Serial the moment resolves
+func (m Moment) Serial() views.Serial {
+	return views.Serial{Number: m.SerialNumber}
+}
+
+// NFTCollectionDisplay mirrors the NFTCollectionDisplay view of
+// AllDay.resolveContractView
+func NFTCollectionDisplay() views.NFTCollectionDisplay {
+	return views.NFTCollectionDisplay{
+		Name:        "NFL All Day",
+		Description: "Officially Licensed Digital Collectibles Featuring the NFL’s Best Highlights. Buy, Sell and Collect Your Favorite NFL Moments",
+		ExternalURL: views.ExternalURL{URL: "https://nflallday.com/"},
+		SquareImage: views.Media{File: views.File{URL: "https://assets.nflallday.com/flow/catalogue/NFLAD_SQUARE.png"}, MediaType: "image/png"},
+		BannerImage: views.Media{File: views.File{URL: "https://assets.nflallday.com/flow/catalogue/NFLAD_BANNER.png"}, MediaType: "image/png"},
+		Socials: map[string]views.ExternalURL{
+			"instagram": {URL: "https://www.instagram.com/nflallday/"},
+			"twitter":   {URL: "https://twitter.com/NFLAllDay"},
+			"discord":   {URL: "https://discord.com/invite/5K6qyTzj2k"},
+		},
+	}
+}
+
+// Renderer renders the views of moments of the AllDay contract deployed in
+// an environment
+type Renderer struct {
+	allDayAddress  flow.Address
+	royaltyAddress flow.Address
+}
+
+// NewRenderer returns a Renderer for the contract deployed in env, which pays
+// royalties to env.RoyaltyAddress
+func NewRenderer(env templates.Environment) *Renderer {
+	return &Renderer{
+		allDayAddress:  flow.HexToAddress(env.AllDayAddress),
+		royaltyAddress: flow.HexToAddress(env.RoyaltyAddress),
+	}
+}
+
+// NFTCollectionData mirrors the NFTCollectionData view of
+// AllDay.resolveContractView
+func (r *Renderer) NFTCollectionData() views.NFTCollectionData {
+	collection := "&A." + r.allDayAddress.Hex() + ".AllDay.Collection"
+	return views.NFTCollectionData{
+		StoragePath:      "/storage/AllDayNFTCollection",
+		PublicPath:       "/public/AllDayNFTCollection",
+		PublicCollection: collection,
+		PublicLinkedType: collection,
+	}
+}
+
+// Royalties mirrors the Royalties view of AllDay.resolveContractView
+func (r *Renderer) Royalties() views.Royalties {
+	return views.Royalties{CutInfos: []views.Royalty{{
+		Receiver:    r.royaltyAddress,
+		Cut:         0.05,
+		Description: "NFL All Day marketplace royalty",
+	}}}
+}
+
+// MomentMetadata returns the views of m in the order
+// read_moment_nft_metadata.cdc returns them
+func (r *Renderer) MomentMetadata(m Moment) views.MomentMetadata {
+	return views.MomentMetadata{
+		Display:              m.Display(),
+		Editions:             m.Editions(),
+		ExternalURL:          m.ExternalURL(),
+		Medias:               m.Medias(),
+		NFTCollectionDisplay: NFTCollectionDisplay(),
+		Royalties:            r.Royalties(),
+		Serial:               m.Serial(),
+		Traits:               m.Traits(),
+		NFTCollectionData:    r.NFTCollectionData(),
+	}
+}
@@ -0,0 +1,133 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/views"
+)
+
+func testMoment(metadata map[string]string, maxMintSize *uint64) Moment {
+	return Moment{
+		ID:           42,
+		SerialNumber: 7,
+		Edition:      model.Edition{ID: 3, SeriesID: 1, SetID: 2, PlayID: 5, MaxMintSize: maxMintSize, Tier: model.TierRare},
+		Play:         model.Play{ID: 5, Classification: "PLAYER_GAME", Metadata: metadata},
+		Series:       model.Series{ID: 1, Name: "Series One"},
+		Set:          model.Set{ID: 2, Name: "Set Two"},
+	}
+}
+
+func TestName(t *testing.T) {
+	moment := testMoment(map[string]string{"playerFirstName": "Apple", "playerLastName": "Alpha", "playType": "Interception"}, nil)
+	assert.Equal(t, "Apple Alpha Interception", moment.Name())
+
+	moment = testMoment(map[string]string{"playType": "Safety"}, nil)
+	assert.Equal(t, "  Safety", moment.Name())
+}
+
+func TestDescription(t *testing.T) {
+	moment := testMoment(map[string]string{"description": "Diving catch"}, nil)
+	assert.Equal(t, "Diving catch", moment.Description())
+
+	moment = testMoment(map[string]string{"description": ""}, nil)
+	assert.Equal(t, "Series One Set Two moment with serial number 7", moment.Description())
+}
+
+func TestMomentFromView(t *testing.T) {
+	view := model.MomentView{
+		ID:           42,
+		SerialNumber: 7,
+		MintingDate:  time.Unix(1700000000, 0).UTC(),
+		Edition:      model.Edition{ID: 3, SeriesID: 1, SetID: 2, PlayID: 5, Tier: model.TierRare},
+		Play:         model.Play{ID: 5, Classification: "PLAYER_GAME", Metadata: map[string]string{}},
+		SeriesName:   "Series One",
+		SetName:      "Set Two",
+	}
+	assert.Equal(t, testMoment(map[string]string{}, nil), MomentFromView(view))
+}
+
+func TestMomentMetadata(t *testing.T) {
+	maxMintSize := uint64(100)
+	moment := testMoment(map[string]string{"playerFirstName": "Apple", "playerLastName": "Alpha", "playType": "Interception"}, &maxMintSize)
+	renderer := NewRenderer(templates.Environment{AllDayAddress: "0xe4cf4bdc1751c65d", RoyaltyAddress: "0x01cf0e2f2f715450"})
+
+	metadata := renderer.MomentMetadata(moment)
+
+	assert.Equal(t, views.Display{
+		Name:        "Apple Alpha Interception",
+		Description: "Series One Set Two moment with serial number 7",
+		Thumbnail:   views.File{URL: "https://media.nflallday.com/editions/3/media/image?format=jpeg&width=256"},
+	}, metadata.Display)
+
+	name := "Set Two: #5"
+	assert.Equal(t, views.Editions{InfoList: []views.Edition{{Name: &name, Number: 7, Max: &maxMintSize}}}, metadata.Editions)
+	assert.Equal(t, "https://nflallday.com/moments/42", metadata.ExternalURL.URL)
+	assert.Equal(t, uint64(7), metadata.Serial.Number)
+
+	assert.Len(t, metadata.Medias.Items, 8)
+	assert.Equal(t, views.Media{
+		File:      views.File{URL: "https://media.nflallday.com/editions/3/media/image-scores?format=jpeg&width=512"},
+		MediaType: "image/jpeg",
+	}, metadata.Medias.Items[5])
+	assert.Equal(t, views.Media{
+		File:      views.File{URL: "https://media.nflallday.com/editions/3/media/video-idle"},
+		MediaType: "video/mp4",
+	}, metadata.Medias.Items[7])
+
+	assert.Equal(t, "NFL All Day", metadata.NFTCollectionDisplay.Name)
+	assert.Equal(t, []views.Royalty{{
+		Receiver:    flow.HexToAddress("01cf0e2f2f715450"),
+		Cut:         0.05,
+		Description: "NFL All Day marketplace royalty",
+	}}, metadata.Royalties.CutInfos)
+	assert.Equal(t, moment.Traits(), metadata.Traits)
+	assert.Equal(t, views.NFTCollectionData{
+		StoragePath:      "/storage/AllDayNFTCollection",
+		PublicPath:       "/public/AllDayNFTCollection",
+		PublicCollection: "&A.e4cf4bdc1751c65d.AllDay.Collection",
+		PublicLinkedType: "&A.e4cf4bdc1751c65d.AllDay.Collection",
+	}, metadata.NFTCollectionData)
+}
+
+func TestTraits(t *testing.T) {
+	moment := testMoment(map[string]string{"playType": "Sack", "teamName": "", "setName": "Renamed"}, nil)
+	moment.Badges = []model.Badge{{Slug: "rookie"}, {Slug: "mvp"}}
+
+	assert.Equal(t, map[string]any{
+		"editionID":    uint64(3),
+		"editionTier":  "RARE",
+		"parallel":     "Standard",
+		"seriesName":   "Series One",
+		"setName":      "Renamed",
+		"serialNumber": uint64(7),
+		"badges":       []model.Badge{{Slug: "mvp"}, {Slug: "rookie"}},
+		"playType":     "Sack",
+	}, moment.TraitMap())
+	assert.Equal(t, []model.Badge{{Slug: "rookie"}, {Slug: "mvp"}}, moment.Badges)
+
+	traits := moment.Traits()
+	assert.Equal(t, moment.TraitMap(), traits.Map())
+	var names []string
+	for _, trait := range traits.Traits {
+		names = append(names, trait.Name)
+		assert.Nil(t, trait.DisplayType)
+		assert.Nil(t, trait.Rarity)
+	}
+	assert.Equal(t, []string{"badges", "editionID", "editionTier", "parallel", "playType", "serialNumber", "seriesName", "setName"}, names)
+
+	moment = testMoment(map[string]string{}, nil)
+	moment.Edition.Parallel = model.ParallelRuby
+	assert.NotContains(t, moment.TraitMap(), "badges")
+	assert.Equal(t, "Ruby", moment.TraitMap()["parallel"])
+}
+
+func TestDictToTraits(t *testing.T) {
+	traits := DictToTraits(map[string]any{"b": uint64(1), "a": "x", "c": true}, []string{"c", "d"})
+	assert.Equal(t, views.Traits{Traits: []views.Trait{{Name: "a", Value: "x"}, {Name: "b", Value: uint64(1)}}}, traits)
+}
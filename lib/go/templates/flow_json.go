@@ -0,0 +1,116 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	nfl "github.com/dapperlabs/nfl-smart-contracts"
+)
+
+// flowJSON is the subset of flow.json needed to build environments
+type flowJSON struct {
+	Contracts map[string]flowJSONContract `json:"contracts"`
+	Networks  map[string]json.RawMessage  `json:"networks"`
+}
+
+// flowJSONContract is a contract entry, which is either a bare source path
+// or an object with a source and per-network aliases
+type flowJSONContract struct {
+	Source  string            `json:"source"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+func (c *flowJSONContract) UnmarshalJSON(data []byte) error {
+	var source string
+	if err := json.Unmarshal(data, &source); err == nil {
+		c.Source = source
+		return nil
+	}
+
+	type contract flowJSONContract
+	return json.Unmarshal(data, (*contract)(c))
+}
+
+// Environments is a set of environments keyed by network name
+type Environments map[string]Environment
+
+// Networks returns the network names in sorted order
+func (envs Environments) Networks() []string {
+	networks := make([]string, 0, len(envs))
+	for network := range envs {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+	return networks
+}
+
+// Get returns the environment for network
+func (envs Environments) Get(network string) (Environment, error) {
+	env, ok := envs[network]
+	if !ok {
+		return Environment{}, fmt.Errorf("templates: unknown network %q, expected one of %v", network, envs.Networks())
+	}
+	return env, nil
+}
+
+// ParseFlowJSON builds an environment for every network in a flow.json file
+// from its contract aliases. Contracts without an alias for a network are
+// left empty in that network's environment.
+func ParseFlowJSON(data []byte) (Environments, error) {
+	var config flowJSON
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("templates: failed to parse flow.json: %w", err)
+	}
+
+	envs := Environments{}
+	environment := func(network string) Environment {
+		env, ok := envs[network]
+		if !ok {
+			env = Environment{Network: network}
+		}
+		return env
+	}
+
+	for network := range config.Networks {
+		envs[network] = environment(network)
+	}
+	for name, contract := range config.Contracts {
+		field, ok := contractAddresses[name]
+		if !ok {
+			continue
+		}
+		for network, address := range contract.Aliases {
+			env := environment(network)
+			*field(&env) = address
+			envs[network] = env
+		}
+	}
+
+	return envs, nil
+}
+
+// LoadFlowJSON builds environments from the flow.json file at path
+func LoadFlowJSON(path string) (Environments, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("templates: failed to read flow.json: %w", err)
+	}
+	return ParseFlowJSON(data)
+}
+
+// DefaultEnvironments builds environments from the flow.json embedded in
+// this repository
+func DefaultEnvironments() (Environments, error) {
+	return ParseFlowJSON(nfl.FlowJSON)
+}
+
+// NetworkEnvironment returns the environment for network from the embedded flow.json
+func NetworkEnvironment(network string) (Environment, error) {
+	envs, err := DefaultEnvironments()
+	if err != nil {
+		return Environment{}, err
+	}
+	return envs.Get(network)
+}
@@ -0,0 +1,63 @@
+package templates
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultEnvironments(t *testing.T) {
+	envs, err := DefaultEnvironments()
+	require.NoError(t, err)
+
+	mainnet, err := envs.Get("mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, "mainnet", mainnet.Network)
+	assert.Equal(t, "e4cf4bdc1751c65d", mainnet.AllDayAddress)
+	assert.Equal(t, "e4cf4bdc1751c65d", mainnet.PackNFTAddress)
+	assert.Equal(t, "18ddf0823a55a0ee", mainnet.IPackNFTAddress)
+	assert.Equal(t, "1d7e57aa55817448", mainnet.NonFungibleTokenAddress)
+	assert.Equal(t, "f233dcee88fe0abe", mainnet.BurnerAddress)
+
+	emulator, err := envs.Get("emulator")
+	require.NoError(t, err)
+	assert.Equal(t, "f8d6e0586b0a20c7", emulator.AllDayAddress)
+	assert.Empty(t, emulator.PackNFTAddress)
+
+	_, err = envs.Get("devnet")
+	assert.ErrorContains(t, err, `unknown network "devnet"`)
+}
+
+func TestParseFlowJSON(t *testing.T) {
+	envs, err := ParseFlowJSON([]byte(`{
+		"contracts": {
+			"AllDay": {"source": "./contracts/AllDay.cdc", "aliases": {"testnet": "4dfd62c88d1b6462"}},
+			"PackNFT": "./contracts/PackNFT.cdc"
+		},
+		"networks": {"testnet": "access.devnet.nodes.onflow.org:9000"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"testnet"}, envs.Networks())
+	assert.Equal(t, "4dfd62c88d1b6462", envs["testnet"].AllDayAddress)
+	assert.Empty(t, envs["testnet"].PackNFTAddress)
+
+	_, err = ParseFlowJSON([]byte(`{"contracts": [`))
+	assert.Error(t, err)
+}
+
+func TestResolveImportsMissingAlias(t *testing.T) {
+	env, err := NetworkEnvironment("emulator")
+	require.NoError(t, err)
+
+	_, err = ResolveImports(GenerateAccountIsAllSetupScript(env), env)
+	var missing *MissingAddressError
+	require.True(t, errors.As(err, &missing))
+	assert.Equal(t, []string{"PackNFT"}, missing.Contracts)
+	assert.EqualError(t, err, "templates: no address for PackNFT on emulator")
+
+	code, err := ResolveImports(GenerateAccountIsSetupScript(env), env)
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "import AllDay from 0xf8d6e0586b0a20c7")
+}
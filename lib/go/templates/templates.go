@@ -0,0 +1,120 @@
+// Package templates returns the AllDay transactions and scripts with their
+// contract imports resolved for a given network.
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const placeholderRoyaltyAddress = "0xALLDAYROYALTYADDRESS"
+
+// Environment holds the addresses of the AllDay contracts and their
+// dependencies on a single network. Addresses are hex strings, with or
+// without the 0x prefix. Empty addresses leave their placeholder untouched.
+type Environment struct {
+	Network                         string
+	NonFungibleTokenAddress         string
+	FungibleTokenAddress            string
+	MetadataViewsAddress            string
+	ViewResolverAddress             string
+	BurnerAddress                   string
+	FungibleTokenSwitchboardAddress string
+	AllDayAddress                   string
+	PackNFTAddress                  string
+	IPackNFTAddress                 string
+	RoyaltyAddress                  string
+}
+
+// contractAddresses maps each contract name used in an import placeholder
+// to its address field on Environment.
+var contractAddresses = map[string]func(env *Environment) *string{
+	"NonFungibleToken":         func(env *Environment) *string { return &env.NonFungibleTokenAddress },
+	"FungibleToken":            func(env *Environment) *string { return &env.FungibleTokenAddress },
+	"MetadataViews":            func(env *Environment) *string { return &env.MetadataViewsAddress },
+	"ViewResolver":             func(env *Environment) *string { return &env.ViewResolverAddress },
+	"Burner":                   func(env *Environment) *string { return &env.BurnerAddress },
+	"FungibleTokenSwitchboard": func(env *Environment) *string { return &env.FungibleTokenSwitchboardAddress },
+	"AllDay":                   func(env *Environment) *string { return &env.AllDayAddress },
+	"PackNFT":                  func(env *Environment) *string { return &env.PackNFTAddress },
+	"IPackNFT":                 func(env *Environment) *string { return &env.IPackNFTAddress },
+}
+
+// unresolvedImportRegexp matches imports that still use a string placeholder,
+// either as import X from "X" or as import "X".
+var unresolvedImportRegexp = regexp.MustCompile(`import\s+(?:\w+\s+from\s+)?"(\w+)"`)
+
+// stringImportRegexp matches imports of the import "X" form
+var stringImportRegexp = regexp.MustCompile(`import\s+"(\w+)"`)
+
+func withHexPrefix(address string) string {
+	if address == "" {
+		return ""
+	}
+	if strings.HasPrefix(address, "0x") {
+		return address
+	}
+	return "0x" + address
+}
+
+// ReplaceAddresses replaces the import placeholders in code with the
+// addresses from env. An import "X" with an address becomes
+// import X from 0x... .
+func ReplaceAddresses(code []byte, env Environment) []byte {
+	code = stringImportRegexp.ReplaceAllFunc(code, func(match []byte) []byte {
+		name := string(stringImportRegexp.FindSubmatch(match)[1])
+		if field, ok := contractAddresses[name]; ok && *field(&env) != "" {
+			return []byte(`import ` + name + ` from "` + name + `"`)
+		}
+		return match
+	})
+
+	var pairs []string
+	for name, field := range contractAddresses {
+		if address := *field(&env); address != "" {
+			pairs = append(pairs, `"`+name+`"`, withHexPrefix(address))
+		}
+	}
+	if env.RoyaltyAddress != "" {
+		pairs = append(pairs, placeholderRoyaltyAddress, withHexPrefix(env.RoyaltyAddress))
+	}
+
+	return []byte(strings.NewReplacer(pairs...).Replace(string(code)))
+}
+
+// MissingAddressError is returned by ResolveImports when code refers to
+// contracts that have no address in the environment.
+type MissingAddressError struct {
+	Network   string
+	Contracts []string
+}
+
+func (e *MissingAddressError) Error() string {
+	network := e.Network
+	if network == "" {
+		network = "environment"
+	}
+	return fmt.Sprintf("templates: no address for %s on %s", strings.Join(e.Contracts, ", "), network)
+}
+
+// ResolveImports is like ReplaceAddresses, but fails with a
+// *MissingAddressError if any placeholder is left unresolved.
+func ResolveImports(code []byte, env Environment) ([]byte, error) {
+	resolved := ReplaceAddresses(code, env)
+
+	var missing []string
+	for _, match := range unresolvedImportRegexp.FindAllSubmatch(resolved, -1) {
+		missing = append(missing, string(match[1]))
+	}
+	if strings.Contains(string(resolved), placeholderRoyaltyAddress) {
+		missing = append(missing, "royalty receiver")
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, &MissingAddressError{Network: env.Network, Contracts: missing}
+	}
+
+	return resolved, nil
+}
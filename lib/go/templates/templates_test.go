@@ -0,0 +1,39 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceAddresses(t *testing.T) {
+	env := Environment{
+		NonFungibleTokenAddress: "f8d6e0586b0a20c7",
+		AllDayAddress:           "0xe4cf4bdc1751c65d",
+	}
+
+	code := string(GenerateMintMomentNFTTransaction(env))
+	assert.Contains(t, code, "import NonFungibleToken from 0xf8d6e0586b0a20c7")
+	assert.Contains(t, code, "import AllDay from 0xe4cf4bdc1751c65d")
+	assert.NotContains(t, code, "\"AllDay\"")
+}
+
+func TestReplaceAddressesLeavesUnsetPlaceholders(t *testing.T) {
+	code := string(GenerateSetupSwitchboardAccountTransaction(Environment{FungibleTokenAddress: "ee82856bf20e2aa6"}))
+	assert.Contains(t, code, "import FungibleToken from 0xee82856bf20e2aa6")
+	assert.Contains(t, code, "import FungibleTokenSwitchboard from \"FungibleTokenSwitchboard\"")
+}
+
+func TestResolveStringImports(t *testing.T) {
+	code := []byte("import \"NonFungibleToken\"\nimport \"AllDay\"\n")
+
+	resolved, err := ResolveImports(code, Environment{NonFungibleTokenAddress: "f8d6e0586b0a20c7", AllDayAddress: "e4cf4bdc1751c65d"})
+	require.NoError(t, err)
+	assert.Equal(t, "import NonFungibleToken from 0xf8d6e0586b0a20c7\nimport AllDay from 0xe4cf4bdc1751c65d\n", string(resolved))
+
+	_, err = ResolveImports(code, Environment{Network: "testnet", AllDayAddress: "e4cf4bdc1751c65d"})
+	var missing *MissingAddressError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"NonFungibleToken"}, missing.Contracts)
+}
@@ -1,14 +1,24 @@
 package test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 
-	"github.com/onflow/cadence"
-	"github.com/onflow/cadence/fixedpoint"
-
 	"github.com/onflow/flow-emulator/emulator"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/allday"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/render"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/views"
 )
 
 // ------------------------------------------------------------
@@ -43,6 +53,23 @@ func TestSeries(t *testing.T) {
 	b := newEmulator()
 	contracts := AllDayDeployContracts(t, b)
 	createTestSeries(t, b, contracts)
+
+	t.Run("Should be able to read series by name", func(t *testing.T) {
+		client := contracts.client(b)
+		series, err := client.GetSeriesByName(context.Background(), "Series Two")
+		require.NoError(t, err)
+		assert.Equal(t, model.Series{ID: 2, Name: "Series Two", Active: false}, series)
+
+		names, err := client.AllSeriesNames(context.Background())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"Series One", "Series Two"}, names)
+	})
+
+	t.Run("Should fail to read a series that does not exist", func(t *testing.T) {
+		_, err := contracts.client(b).GetSeries(context.Background(), 99)
+		assert.ErrorContains(t, err, "Cannot borrow series, no such id")
+		assert.ErrorIs(t, err, allday.ErrSeriesNotFound)
+	})
 }
 
 func createTestSeries(t *testing.T, b *emulator.Blockchain, contracts Contracts) {
@@ -53,7 +80,7 @@ func createTestSeries(t *testing.T, b *emulator.Blockchain, contracts Contracts)
 			contracts,
 			"Series One",
 			1,
-			false,
+			nil,
 		)
 	})
 
@@ -64,7 +91,18 @@ func createTestSeries(t *testing.T, b *emulator.Blockchain, contracts Contracts)
 			contracts,
 			"Series Two",
 			2,
-			false,
+			nil,
+		)
+	})
+
+	t.Run("Should NOT be able to create a series with an existing name", func(t *testing.T) {
+		testCreateSeries(
+			t,
+			b,
+			contracts,
+			"Series One",
+			3,
+			revertsWith(allday.ErrDuplicateSeries, "A Series with that name already exists"),
 		)
 	})
 
@@ -74,7 +112,17 @@ func createTestSeries(t *testing.T, b *emulator.Blockchain, contracts Contracts)
 			b,
 			contracts,
 			2,
-			false,
+			nil,
+		)
+	})
+
+	t.Run("Should NOT be able to close a series that is already closed", func(t *testing.T) {
+		testCloseSeries(
+			t,
+			b,
+			contracts,
+			2,
+			revertsWith(allday.ErrSeriesClosed, "not active"),
 		)
 	})
 }
@@ -85,17 +133,17 @@ func testCreateSeries(
 	contracts Contracts,
 	seriesName string,
 	shouldBeID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	createSeries(
 		t,
 		b,
 		contracts,
 		seriesName,
-		false,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		series := getSeriesData(t, b, contracts, shouldBeID)
 		assert.Equal(t, shouldBeID, series.ID)
 		assert.Equal(t, seriesName, series.Name)
@@ -108,7 +156,7 @@ func testCloseSeries(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	seriesID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	wasActive := getSeriesData(t, b, contracts, seriesID).Active
 	closeSeries(
@@ -116,12 +164,12 @@ func testCloseSeries(
 		b,
 		contracts,
 		seriesID,
-		shouldRevert,
+		expectedErr,
 	)
 
 	series := getSeriesData(t, b, contracts, seriesID)
 	assert.Equal(t, seriesID, series.ID)
-	if !shouldRevert {
+	if expectedErr == nil {
 		assert.Equal(t, false, series.Active)
 	} else {
 		assert.Equal(t, wasActive, series.Active)
@@ -146,7 +194,7 @@ func createTestSets(t *testing.T, b *emulator.Blockchain, contracts Contracts) {
 			contracts,
 			"Set One",
 			1,
-			false,
+			nil,
 		)
 	})
 
@@ -157,7 +205,18 @@ func createTestSets(t *testing.T, b *emulator.Blockchain, contracts Contracts) {
 			contracts,
 			"Set Two",
 			2,
-			false,
+			nil,
+		)
+	})
+
+	t.Run("Should NOT be able to create a set with an existing name", func(t *testing.T) {
+		testCreateSet(
+			t,
+			b,
+			contracts,
+			"Set One",
+			3,
+			revertsWith(allday.ErrDuplicateSet, "A Set with that name already exists"),
 		)
 	})
 }
@@ -168,17 +227,17 @@ func testCreateSet(
 	contracts Contracts,
 	setName string,
 	shouldBeID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	createSet(
 		t,
 		b,
 		contracts,
 		setName,
-		false,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		set := getSetData(t, b, contracts, shouldBeID)
 		assert.Equal(t, shouldBeID, set.ID)
 		assert.Equal(t, setName, set.Name)
@@ -209,7 +268,7 @@ func createTestPlays(t *testing.T, b *emulator.Blockchain, contracts Contracts)
 			"TEST_CLASSIFICATION",
 			metadata,
 			1,
-			false,
+			nil,
 		)
 	})
 
@@ -226,7 +285,7 @@ func createTestPlays(t *testing.T, b *emulator.Blockchain, contracts Contracts)
 			"TEST_CLASSIFICATION",
 			metadata,
 			2,
-			false,
+			nil,
 		)
 	})
 }
@@ -238,7 +297,7 @@ func testCreatePlay(
 	classification string,
 	metadata map[string]string,
 	shouldBeID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	createPlay(
 		t,
@@ -246,10 +305,10 @@ func testCreatePlay(
 		contracts,
 		classification,
 		metadata,
-		false,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		play := getPlayData(t, b, contracts, shouldBeID)
 		assert.Equal(t, shouldBeID, play.ID)
 		assert.Equal(t, classification, play.Classification)
@@ -277,7 +336,7 @@ func testCreateEdition(
 	tier string,
 	parallel *string,
 	shouldBeID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	createEdition(
 		t,
@@ -289,23 +348,22 @@ func testCreateEdition(
 		maxMintSize,
 		tier,
 		parallel,
-		shouldRevert,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		edition := getEditionData(t, b, contracts, shouldBeID)
 		assert.Equal(t, shouldBeID, edition.ID)
 		assert.Equal(t, seriesID, edition.SeriesID)
 		assert.Equal(t, setID, edition.SetID)
 		assert.Equal(t, playID, edition.PlayID)
-		assert.Equal(t, tier, edition.Tier)
-		if maxMintSize != nil {
-			assert.Equal(t, &maxMintSize, &edition.MaxMintSize)
-		}
+		assert.Equal(t, model.Tier(tier), edition.Tier)
+		assert.Equal(t, maxMintSize, edition.MaxMintSize)
+		assert.Equal(t, uint64(0), edition.NumMinted)
 		if parallel != nil {
-			assert.Equal(t, *parallel, edition.Parallel)
+			assert.Equal(t, model.Parallel(*parallel), edition.Parallel)
 		} else {
-			assert.Equal(t, "Standard", edition.Parallel)
+			assert.Equal(t, model.ParallelStandard, edition.Parallel)
 		}
 	}
 }
@@ -316,19 +374,20 @@ func testCloseEdition(
 	contracts Contracts,
 	editionID uint64,
 	shouldBeID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	closeEdition(
 		t,
 		b,
 		contracts,
 		editionID,
-		false,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		edition := getEditionData(t, b, contracts, shouldBeID)
 		assert.Equal(t, shouldBeID, edition.ID)
+		assert.True(t, edition.MaxMintSizeReached())
 	}
 }
 
@@ -350,7 +409,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			nil,
 			1,
-			false,
+			nil,
 		)
 	})
 
@@ -366,7 +425,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			nil,
 			2,
-			false,
+			nil,
 		)
 	})
 
@@ -382,7 +441,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"invalidtesttier",
 			nil,
 			3,
-			true,
+			revertsWith(allday.ErrInvalidTier, "tier is not a valid tier"),
 		)
 	})
 
@@ -398,7 +457,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			nil,
 			3,
-			false,
+			nil,
 		)
 	})
 
@@ -414,7 +473,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			stringPtr("invalidtestparallel"),
 			4,
-			true,
+			revertsWith(allday.ErrInvalidParallel, "parallel is not a valid parallel"),
 		)
 	})
 
@@ -430,7 +489,7 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			stringPtr("Ruby"),
 			4,
-			false,
+			nil,
 		)
 	})
 
@@ -446,26 +505,28 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			"COMMON",
 			nil,
 			5,
-			true,
+			revertsWith(allday.ErrSeriesClosed, "cannot create an Edition with a closed Series"),
 		)
 	})
 
 	t.Run("Should be able to create an Edition with a Set/Play combination that already exists but with a different tier", func(t *testing.T) {
 		//Mint LEGENDARY edition
 		testCreateEdition(t, b, contracts, 1 /*seriesID*/, 1 /*setID*/, 2 /*playID*/, nil,
-			"LEGENDARY" /*tier*/, nil, 5 /*shouldBEID*/, false /*shouldRevert*/)
+			"LEGENDARY" /*tier*/, nil, 5 /*shouldBEID*/, nil /*expectedErr*/)
 	})
 
 	t.Run("Should NOT be able to mint new edition using the same set/play/tier combination", func(t *testing.T) {
 		//Mint COMMON edition again, tx should revert
 		testCreateEdition(t, b, contracts, 1 /*seriesID*/, 1 /*setID*/, 2 /*playID*/, nil,
-			"COMMON" /*tier*/, nil, 6 /*shouldBEID*/, true /*shouldRevert*/)
+			"COMMON" /*tier*/, nil, 6 /*shouldBEID*/, revertsWith(allday.ErrDuplicateEdition,
+				"set play tier combination already exists in an edition"))
 	})
 
 	t.Run("Should NOT be able to mint new edition using the same set/play/tier/parallel", func(t *testing.T) {
-		//Mint COMMON edition again, tx should revert
+		//Mint COMMON Ruby edition again, tx should revert
 		testCreateEdition(t, b, contracts, 1 /*seriesID*/, 1 /*setID*/, 2 /*playID*/, nil,
-			"COMMON" /*tier*/, stringPtr("validtestparallel"), 6 /*shouldBEID*/, true /*shouldRevert*/)
+			"COMMON" /*tier*/, stringPtr("Ruby"), 6 /*shouldBEID*/, revertsWith(allday.ErrDuplicateEdition,
+				"set play tier combination already exists in an edition"))
 	})
 
 	t.Run("Should be able to close and edition that has no max mint size", func(t *testing.T) {
@@ -475,7 +536,18 @@ func createTestEditions(t *testing.T, b *emulator.Blockchain, contracts Contract
 			contracts,
 			3,
 			3,
-			false,
+			nil,
+		)
+	})
+
+	t.Run("Should NOT be able to close an edition that is already closed", func(t *testing.T) {
+		testCloseEdition(
+			t,
+			b,
+			contracts,
+			3,
+			3,
+			revertsWith(allday.ErrEditionFull, "max number of minted moments has already been reached"),
 		)
 	})
 }
@@ -501,7 +573,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(1),
 			uint64(1),
-			false,
+			nil,
 		)
 	})
 
@@ -515,7 +587,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(2),
 			uint64(2),
-			false,
+			nil,
 		)
 	})
 
@@ -529,7 +601,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(3),
 			uint64(1),
-			false,
+			nil,
 		)
 	})
 
@@ -543,7 +615,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(4),
 			uint64(2),
-			false,
+			nil,
 		)
 	})
 
@@ -557,7 +629,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(3),
 			uint64(3),
-			true,
+			revertsWith(allday.ErrEditionFull, "max number of minted moments has been reached"),
 		)
 	})
 
@@ -571,7 +643,7 @@ func TestMomentNFTs(t *testing.T) {
 			userAddress,
 			uint64(1),
 			uint64(1),
-			true,
+			revertsWith(allday.ErrEditionFull, "max number of minted moments has been reached"),
 		)
 	})
 }
@@ -585,7 +657,7 @@ func testMintMomentNFT(
 	userAddress flow.Address,
 	shouldBeID uint64,
 	shouldBeSerialNumber uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	// Make sure the total supply of NFTs is tracked correctly
 	previousSupply := getMomentNFTSupply(t, b, contracts)
@@ -597,11 +669,11 @@ func testMintMomentNFT(
 		userAddress,
 		editionID,
 		serialNumber,
-		shouldRevert,
+		expectedErr,
 	)
 
 	newSupply := getMomentNFTSupply(t, b, contracts)
-	if !shouldRevert {
+	if expectedErr == nil {
 		assert.Equal(t, previousSupply+uint64(1), newSupply)
 
 		nftProperties := getMomentNFTProperties(
@@ -617,7 +689,7 @@ func testMintMomentNFT(
 		assert.Equal(t, shouldBeSerialNumber, nftProperties.SerialNumber)
 		//FIXME: query the block time and check equality.
 		//       Here we just make sure it's nonzero.
-		assert.Less(t, uint64(0), nftProperties.MintingDate)
+		assert.Less(t, int64(0), nftProperties.MintingDate.Unix())
 	} else {
 		assert.Equal(t, previousSupply, newSupply)
 	}
@@ -632,144 +704,106 @@ func TestMomentNFTMetadataViews(t *testing.T) {
 	userAddress, userSigner := createAccount(t, b)
 	setupAllDay(t, b, userAddress, userSigner, contracts)
 	createTestEditions(t, b, contracts)
-	mintMomentNFT(t, b, contracts, userAddress /*editionID*/, 1, nil /*shouldRevert*/, false)
+	mintMomentNFT(t, b, contracts, userAddress, 1 /*editionID*/, nil /*serialNumber*/, nil)
 
 	t.Run("Should be able to get moment's metadata", func(t *testing.T) {
-		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1, false)
+		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1)
 
 		//Validate Display
-		displayView := result[0].FieldsMappedByName()
-		assert.Equal(t, "Apple Alpha Interception", string(displayView["name"].(cadence.String)))
-		assert.Equal(t, "Fabulous diving interception by AA", string(displayView["description"].(cadence.String)))
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image?format=jpeg&width=256",
-			string(displayView["thumbnail"].(cadence.Struct).FieldsMappedByName()["url"].(cadence.String)))
+		assert.Equal(t, "Apple Alpha Interception", result.Display.Name)
+		assert.Equal(t, "Fabulous diving interception by AA", result.Display.Description)
+		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image?format=jpeg&width=256", result.Display.Thumbnail.URI())
 
 		//Validate Editions
-		editionsView := result[1]
-		edition := editionsView.FieldsMappedByName()["infoList"].(cadence.Array).Values[0].(cadence.Struct).FieldsMappedByName()
-		assert.Equal(t, "Set One: #1", string(edition["name"].(cadence.Optional).Value.(cadence.String)))
-		assert.Equal(t, uint64(1), uint64(edition["number"].(cadence.UInt64)))
-		assert.Equal(t, uint64(2), uint64(edition["max"].(cadence.Optional).Value.(cadence.UInt64)))
+		require.Len(t, result.Editions.InfoList, 1)
+		edition := result.Editions.InfoList[0]
+		require.NotNil(t, edition.Name)
+		assert.Equal(t, "Set One: #1", *edition.Name)
+		assert.Equal(t, uint64(1), edition.Number)
+		require.NotNil(t, edition.Max)
+		assert.Equal(t, uint64(2), *edition.Max)
 
 		// Validate External URL
-		externalURLView := result[2]
-		assert.Equal(t, "https://nflallday.com/moments/1", string(externalURLView.FieldsMappedByName()["url"].(cadence.String)))
+		assert.Equal(t, "https://nflallday.com/moments/1", result.ExternalURL.URL)
 
 		//Validate Medias
-		mediasView := result[3]
-		medias := mediasView.FieldsMappedByName()["items"].(cadence.Array)
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image?format=jpeg&width=512",
-			getMediaPath(medias.Values[0]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[0]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image-details?format=jpeg&width=512",
-			getMediaPath(medias.Values[1]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[1]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image-logo?format=jpeg&width=512",
-			getMediaPath(medias.Values[2]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[2]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image-legal?format=jpeg&width=512",
-			getMediaPath(medias.Values[3]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[3]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image-player?format=jpeg&width=512",
-			getMediaPath(medias.Values[4]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[4]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image-scores?format=jpeg&width=512",
-			getMediaPath(medias.Values[5]))
-		assert.Equal(t, "image/jpeg", getMediaType(medias.Values[5]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/video",
-			getMediaPath(medias.Values[6]))
-		assert.Equal(t, "video/mp4", getMediaType(medias.Values[6]))
-
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/video-idle",
-			getMediaPath(medias.Values[7]))
-		assert.Equal(t, "video/mp4", getMediaType(medias.Values[7]))
+		media := func(path, mediaType string) views.Media {
+			return views.Media{File: views.File{URL: "https://media.nflallday.com/editions/1/media/" + path}, MediaType: mediaType}
+		}
+		assert.Equal(t, []views.Media{
+			media("image?format=jpeg&width=512", "image/jpeg"),
+			media("image-details?format=jpeg&width=512", "image/jpeg"),
+			media("image-logo?format=jpeg&width=512", "image/jpeg"),
+			media("image-legal?format=jpeg&width=512", "image/jpeg"),
+			media("image-player?format=jpeg&width=512", "image/jpeg"),
+			media("image-scores?format=jpeg&width=512", "image/jpeg"),
+			media("video", "video/mp4"),
+			media("video-idle", "video/mp4"),
+		}, result.Medias.Items)
 
 		//Validate NFTCollectionDisplay
-		collectionDisplay := result[4].FieldsMappedByName()
-		assert.Equal(t, "NFL All Day", string(collectionDisplay["name"].(cadence.String)))
+		collectionDisplay := result.NFTCollectionDisplay
+		assert.Equal(t, "NFL All Day", collectionDisplay.Name)
 		assert.Equal(t, "Officially Licensed Digital Collectibles Featuring the NFL’s Best Highlights. Buy, Sell and Collect Your Favorite NFL Moments",
-			string(collectionDisplay["description"].(cadence.String)))
-		assert.Equal(t, "https://nflallday.com/", string(collectionDisplay["externalURL"].(cadence.Struct).FieldsMappedByName()["url"].(cadence.String)))
-		assert.Equal(t, "https://assets.nflallday.com/flow/catalogue/NFLAD_SQUARE.png",
-			getMediaPath(collectionDisplay["squareImage"]))
-		assert.Equal(t, "image/png", getMediaType(collectionDisplay["squareImage"]))
-		assert.Equal(t, "https://assets.nflallday.com/flow/catalogue/NFLAD_BANNER.png",
-			getMediaPath(collectionDisplay["bannerImage"]))
-		assert.Equal(t, "image/png", getMediaType(collectionDisplay["bannerImage"]))
-		socials := map[string]cadence.Struct{}
-		for _, kvPair := range collectionDisplay["socials"].(cadence.Dictionary).Pairs {
-			socials[string(kvPair.Key.(cadence.String))] = kvPair.Value.(cadence.Struct)
-		}
-		assert.Equal(t, "https://www.instagram.com/nflallday/", string(socials["instagram"].FieldsMappedByName()["url"].(cadence.String)))
-		assert.Equal(t, "https://twitter.com/NFLAllDay", string(socials["twitter"].FieldsMappedByName()["url"].(cadence.String)))
-		assert.Equal(t, "https://discord.com/invite/5K6qyTzj2k", string(socials["discord"].FieldsMappedByName()["url"].(cadence.String)))
+			collectionDisplay.Description)
+		assert.Equal(t, "https://nflallday.com/", collectionDisplay.ExternalURL.URL)
+		assert.Equal(t, views.Media{
+			File:      views.File{URL: "https://assets.nflallday.com/flow/catalogue/NFLAD_SQUARE.png"},
+			MediaType: "image/png",
+		}, collectionDisplay.SquareImage)
+		assert.Equal(t, views.Media{
+			File:      views.File{URL: "https://assets.nflallday.com/flow/catalogue/NFLAD_BANNER.png"},
+			MediaType: "image/png",
+		}, collectionDisplay.BannerImage)
+		assert.Equal(t, map[string]views.ExternalURL{
+			"instagram": {URL: "https://www.instagram.com/nflallday/"},
+			"twitter":   {URL: "https://twitter.com/NFLAllDay"},
+			"discord":   {URL: "https://discord.com/invite/5K6qyTzj2k"},
+		}, collectionDisplay.Socials)
 
 		// Validate Royalties
-		royaltiesView := result[5].FieldsMappedByName()
-		royalty := royaltiesView["cutInfos"].(cadence.Array).Values[0].(cadence.Struct).FieldsMappedByName()
-		assert.Equal(t, contracts.RoyaltyAddress, flow.HexToAddress(royalty["receiver"].(cadence.Capability).Address.Hex()))
-		assert.Equal(t, uint64(0.05*fixedpoint.Fix64Factor), uint64(royalty["cut"].(cadence.UFix64)))
-		assert.Equal(t, "NFL All Day marketplace royalty", string(royalty["description"].(cadence.String)))
+		assert.Equal(t, []views.Royalty{{
+			Receiver:    contracts.RoyaltyAddress,
+			Cut:         0.05,
+			Description: "NFL All Day marketplace royalty",
+		}}, result.Royalties.CutInfos)
 
 		// Validate Serial
-		serialView := result[6]
-		assert.Equal(t, uint64(1), uint64(serialView.FieldsMappedByName()["number"].(cadence.UInt64)))
+		assert.Equal(t, uint64(1), result.Serial.Number)
 
 		// Validate Traits
-		traitsView := result[7]
-		traits := traitsView.FieldsMappedByName()["traits"].(cadence.Array)
-		traitsMap := make(map[string]any)
-		for _, trait := range traits.Values {
-			traitsMap[string(trait.(cadence.Struct).FieldsMappedByName()["name"].(cadence.String))] = trait.(cadence.Struct).FieldsMappedByName()["value"]
-		}
-		assert.Equal(t, cadence.String("COMMON"), traitsMap["editionTier"])
-		assert.Equal(t, cadence.String("Series One"), traitsMap["seriesName"])
-		assert.Equal(t, cadence.String("Set One"), traitsMap["setName"])
-		assert.Equal(t, cadence.NewUInt64(1), traitsMap["serialNumber"])
-		assert.Equal(t, cadence.String("Interception"), traitsMap["playType"])
+		traits := result.Traits.Map()
+		assert.Equal(t, "COMMON", traits["editionTier"])
+		assert.Equal(t, "Series One", traits["seriesName"])
+		assert.Equal(t, "Set One", traits["setName"])
+		assert.Equal(t, uint64(1), traits["serialNumber"])
+		assert.Equal(t, "Interception", traits["playType"])
 	})
 }
 
-func getMediaPath(media interface{}) interface{} {
-	return string(media.(cadence.Struct).FieldsMappedByName()["file"].(cadence.Struct).FieldsMappedByName()["url"].(cadence.String))
-}
-
-func getMediaType(media interface{}) interface{} {
-	return string(media.(cadence.Struct).FieldsMappedByName()["mediaType"].(cadence.String))
-}
-
 func TestUpdatePlayDescription(t *testing.T) {
 	b := newEmulator()
 	contracts := AllDayDeployContracts(t, b)
 	userAddress, userSigner := createAccount(t, b)
 	setupAllDay(t, b, userAddress, userSigner, contracts)
 	createTestEditions(t, b, contracts)
-	mintMomentNFT(t, b, contracts, userAddress /*editionID*/, 1, nil /*shouldRevert*/, false)
+	mintMomentNFT(t, b, contracts, userAddress, 1 /*editionID*/, nil /*serialNumber*/, nil)
 
 	t.Run("Should be able to update play's description", func(t *testing.T) {
-		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1, false)
+		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1)
 
 		//Validate Display
-		displayView := result[0].FieldsMappedByName()
-		assert.Equal(t, "Apple Alpha Interception", string(displayView["name"].(cadence.String)))
-		assert.Equal(t, "Fabulous diving interception by AA", string(displayView["description"].(cadence.String)))
-		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image?format=jpeg&width=256",
-			string(displayView["thumbnail"].(cadence.Struct).FieldsMappedByName()["url"].(cadence.String)))
+		assert.Equal(t, "Apple Alpha Interception", result.Display.Name)
+		assert.Equal(t, "Fabulous diving interception by AA", result.Display.Description)
+		assert.Equal(t, "https://media.nflallday.com/editions/1/media/image?format=jpeg&width=256", result.Display.Thumbnail.URI())
 
 		//Update play description
 		newPlayDescription := "A new play description"
-		updatePlayDescription(t, b, contracts, 1 /*playID*/, newPlayDescription, false /*shouldRevert*/)
+		updatePlayDescription(t, b, contracts, 1 /*playID*/, newPlayDescription, nil /*expectedErr*/)
 
 		//Validate Display has been updated
-		result = getMomentNFTMetadata(t, b, contracts, userAddress, 1, false)
-		displayView = result[0].FieldsMappedByName()
-		assert.Equal(t, newPlayDescription, string(displayView["description"].(cadence.String)))
+		result = getMomentNFTMetadata(t, b, contracts, userAddress, 1)
+		assert.Equal(t, newPlayDescription, result.Display.Description)
 
 	})
 }
@@ -780,13 +814,12 @@ func TestUpdatePlayDynamicMetadata(t *testing.T) {
 	userAddress, userSigner := createAccount(t, b)
 	setupAllDay(t, b, userAddress, userSigner, contracts)
 	createTestEditions(t, b, contracts)
-	mintMomentNFT(t, b, contracts, userAddress /*editionID*/, 1, nil /*shouldRevert*/, false)
+	mintMomentNFT(t, b, contracts, userAddress, 1 /*editionID*/, nil /*serialNumber*/, nil)
 
 	t.Run("Should be able to update play's dynamic metadata", func(t *testing.T) {
 		//Validate initial Display
-		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1, false)
-		displayView := result[0].FieldsMappedByName()
-		assert.Equal(t, "Apple Alpha Interception", string(displayView["name"].(cadence.String)))
+		result := getMomentNFTMetadata(t, b, contracts, userAddress, 1)
+		assert.Equal(t, "Apple Alpha Interception", result.Display.Name)
 
 		//Update play metadata
 		teamName := "New Team"
@@ -795,28 +828,17 @@ func TestUpdatePlayDynamicMetadata(t *testing.T) {
 		var playerNumber *string
 		var playerPosition *string
 		updatePlayDynamicMetadata(t, b, contracts, 1 /*playID*/, &teamName, playerFirstName, &playerLastName,
-			playerNumber, playerPosition, false /*shouldRevert*/)
+			playerNumber, playerPosition, nil /*expectedErr*/)
 
 		//Validate Display has been updated
-		result = getMomentNFTMetadata(t, b, contracts, userAddress, 1, false)
-		displayView = result[0].FieldsMappedByName()
-		assert.Equal(t, "Apple Charlie Interception", string(displayView["name"].(cadence.String)))
+		result = getMomentNFTMetadata(t, b, contracts, userAddress, 1)
+		assert.Equal(t, "Apple Charlie Interception", result.Display.Name)
 
 		//Validate Play metadata has been updated
-		traitsView := result[7]
-		traits := traitsView.FieldsMappedByName()["traits"].(cadence.Array).Values
-		for _, trait := range traits {
-			ts := trait.(cadence.Struct).FieldsMappedByName()
-			if string(ts["name"].(cadence.String)) == "teamName" {
-				assert.Equal(t, teamName, string(ts["value"].(cadence.String)))
-			}
-			if string(ts["name"].(cadence.String)) == "playerFirstName" {
-				assert.Equal(t, "Apple", string(ts["value"].(cadence.String)))
-			}
-			if string(ts["name"].(cadence.String)) == "playerLastName" {
-				assert.Equal(t, playerLastName, string(ts["value"].(cadence.String)))
-			}
-		}
+		traits := result.Traits.Map()
+		assert.Equal(t, teamName, traits["teamName"])
+		assert.Equal(t, "Apple", traits["playerFirstName"])
+		assert.Equal(t, playerLastName, traits["playerLastName"])
 	})
 }
 
@@ -830,7 +852,7 @@ func TestMintMomentMulti(t *testing.T) {
 	editions := []uint64{1, 2}
 	serialNumbers := []*uint64{nil, nil}
 
-	mintMomentNFTMulti(t, b, contracts, userAddress /*editionID*/, editions, serialNumbers /*shouldRevert*/, false)
+	mintMomentNFTMulti(t, b, contracts, userAddress, editions, serialNumbers, nil)
 
 	t.Run("Should have a serial number of 1", func(t *testing.T) {
 		nft := getMomentNFTProperties(t, b, contracts, userAddress, 1)
@@ -864,7 +886,7 @@ func TestBadges(t *testing.T) {
 		userAddress,
 		uint64(1),
 		uint64(1),
-		false,
+		nil,
 	)
 
 	createTestBadges(t, b, contracts, userAddress)
@@ -881,7 +903,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"Badge for rookie year moments",
 			true,
 			"rookie-year-v2",
-			false,
+			nil,
 		)
 	})
 
@@ -910,7 +932,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"Badge for playoff moments",
 			true,
 			"playoff-bound-v2",
-			false,
+			nil,
 		)
 	})
 
@@ -930,7 +952,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			&visible,
 			nil,
 			metadata,
-			false,
+			nil,
 		)
 
 		// Verify the update
@@ -952,7 +974,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			EntityTypePlay,
 			1, // playID
 			metadata,
-			false,
+			nil,
 		)
 	})
 
@@ -966,7 +988,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			EntityTypeEdition,
 			1, // editionID
 			metadata,
-			false,
+			nil,
 		)
 	})
 
@@ -980,7 +1002,20 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			EntityTypeMoment,
 			1, // momentID
 			metadata,
-			false,
+			nil,
+		)
+	})
+
+	t.Run("Should NOT be able to add a badge to a moment twice", func(t *testing.T) {
+		testAddBadgeToEntity(
+			t,
+			b,
+			contracts,
+			"rookie-year",
+			EntityTypeMoment,
+			1, // momentID
+			map[string]string{},
+			revertsWith(allday.ErrBadgeAlreadyAdded, "badge slug already added to moment"),
 		)
 	})
 
@@ -1000,7 +1035,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"rookie-year",
 			EntityTypePlay,
 			1, // playID
-			false,
+			nil,
 		)
 
 	})
@@ -1013,7 +1048,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"playoff-bound",
 			EntityTypeEdition,
 			1, // editionID
-			false,
+			nil,
 		)
 
 	})
@@ -1026,7 +1061,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"rookie-year",
 			EntityTypeMoment,
 			1, // momentID
-			false,
+			nil,
 		)
 
 	})
@@ -1041,7 +1076,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"Another badge for rookie year moments",
 			true,
 			"another-rookie-year-v2",
-			true, // Should revert
+			revertsWith(allday.ErrDuplicateBadge, "badge already exists"),
 		)
 	})
 
@@ -1056,20 +1091,20 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 			"Badge for deletion testing",
 			true,
 			"temporary-badge-v2",
-			false,
+			nil,
 		)
 
 		// Add the badge to a play, edition, and moment to test full cleanup
-		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypePlay, 1, map[string]string{"type": "test"}, false)
-		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypeEdition, 1, map[string]string{"type": "test"}, false)
-		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypeMoment, 1, map[string]string{"type": "test"}, false)
+		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypePlay, 1, map[string]string{"type": "test"}, nil)
+		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypeEdition, 1, map[string]string{"type": "test"}, nil)
+		testAddBadgeToEntity(t, b, contracts, "temporary-badge", EntityTypeMoment, 1, map[string]string{"type": "test"}, nil)
 
 		// Verify badge exists before deletion
 		exists := badgeExists(t, b, contracts, "temporary-badge")
 		assert.Equal(t, true, exists)
 
 		// Delete the badge
-		testDeleteBadge(t, b, contracts, "temporary-badge", false)
+		testDeleteBadge(t, b, contracts, "temporary-badge", nil)
 
 		// Verify badge no longer exists
 		exists = badgeExists(t, b, contracts, "temporary-badge")
@@ -1077,7 +1112,7 @@ func createTestBadges(t *testing.T, b *emulator.Blockchain, contracts Contracts,
 	})
 
 	t.Run("Should not be able to delete non-existent badge", func(t *testing.T) {
-		testDeleteBadge(t, b, contracts, "non-existent-badge", true) // Should revert
+		testDeleteBadge(t, b, contracts, "non-existent-badge", revertsWith(allday.ErrBadgeNotFound, "badge doesn't exist"))
 	})
 }
 
@@ -1090,7 +1125,7 @@ func testCreateBadge(
 	description string,
 	visible bool,
 	slugV2 string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	createBadge(
 		t,
@@ -1101,10 +1136,10 @@ func testCreateBadge(
 		description,
 		visible,
 		slugV2,
-		shouldRevert,
+		expectedErr,
 	)
 
-	if !shouldRevert {
+	if expectedErr == nil {
 		exists := badgeExists(t, b, contracts, slug)
 		assert.Equal(t, true, exists)
 
@@ -1128,7 +1163,7 @@ func testUpdateBadge(
 	visible *bool,
 	slugV2 *string,
 	metadata map[string]string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	updateBadge(
 		t,
@@ -1140,7 +1175,7 @@ func testUpdateBadge(
 		visible,
 		slugV2,
 		metadata,
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -1152,7 +1187,7 @@ func testAddBadgeToEntity(
 	entityType string,
 	entityID uint64,
 	metadata map[string]string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	addBadgeToEntity(
 		t,
@@ -1162,7 +1197,7 @@ func testAddBadgeToEntity(
 		entityType,
 		entityID,
 		metadata,
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -1173,7 +1208,7 @@ func testRemoveBadgeFromEntity(
 	badgeSlug string,
 	entityType string,
 	entityID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	removeBadgeFromEntity(
 		t,
@@ -1182,7 +1217,7 @@ func testRemoveBadgeFromEntity(
 		badgeSlug,
 		entityType,
 		entityID,
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -1191,17 +1226,939 @@ func testDeleteBadge(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	badgeSlug string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
 	deleteBadge(
 		t,
 		b,
 		contracts,
 		badgeSlug,
-		shouldRevert,
+		expectedErr,
 	)
 }
 
+// ------------------------------------------------------------
+// Events
+// ------------------------------------------------------------
+func TestAllDayEvents(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+
+	createSeries(t, b, contracts, "Series One", nil)
+	assert.Equal(t, []events.Event{events.SeriesCreated{ID: 1, Name: "Series One"}}, latestEvents(t, b, contracts))
+
+	createSet(t, b, contracts, "Set One", nil)
+	assert.Equal(t, []events.Event{events.SetCreated{ID: 1, Name: "Set One"}}, latestEvents(t, b, contracts))
+
+	metadata := map[string]string{"playerFirstName": "Apple"}
+	createPlay(t, b, contracts, "PLAY_TYPE", metadata, nil)
+	assert.Equal(t, []events.Event{events.PlayCreated{ID: 1, Classification: "PLAY_TYPE", Metadata: metadata}}, latestEvents(t, b, contracts))
+
+	createEdition(t, b, contracts, 1, 1, 1, uint64Ptr(2), "COMMON", stringPtr("Ruby"), nil)
+	assert.Equal(t, []events.Event{events.EditionCreated{
+		ID:          1,
+		SeriesID:    1,
+		SetID:       1,
+		PlayID:      1,
+		MaxMintSize: uint64Ptr(2),
+		Tier:        model.TierCommon,
+		Parallel:    model.ParallelRuby,
+	}}, latestEvents(t, b, contracts))
+
+	mintMomentNFT(t, b, contracts, userAddress, 1, nil, nil)
+	assert.Equal(t, []events.Event{
+		events.MomentNFTMinted{ID: 1, EditionID: 1, SerialNumber: 1},
+		events.Deposit{ID: 1, To: &userAddress},
+	}, latestEvents(t, b, contracts))
+
+	otherAddress, otherSigner := createAccount(t, b)
+	setupAllDay(t, b, otherAddress, otherSigner, contracts)
+	transferMomentNFT(t, b, contracts, userAddress, userSigner, 1, otherAddress, nil)
+	assert.Equal(t, []events.Event{
+		events.Withdraw{ID: 1, From: &userAddress},
+		events.Deposit{ID: 1, To: &otherAddress},
+	}, latestEvents(t, b, contracts))
+
+	closeEdition(t, b, contracts, 1, nil)
+	assert.Equal(t, []events.Event{events.EditionClosed{ID: 1}}, latestEvents(t, b, contracts))
+
+	closeSeries(t, b, contracts, 1, nil)
+	assert.Equal(t, []events.Event{events.SeriesClosed{ID: 1}}, latestEvents(t, b, contracts))
+
+	createBadge(t, b, contracts, "rookie", "Rookie", "First season", true, "rookie-v2", nil)
+	assert.Equal(t, []events.Event{events.BadgeCreated{
+		Slug:        "rookie",
+		Title:       "Rookie",
+		Description: "First season",
+		Visible:     true,
+		SlugV2:      "rookie-v2",
+		Metadata:    map[string]string{},
+	}}, latestEvents(t, b, contracts))
+
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 1, map[string]string{}, nil)
+	assert.Equal(t, []events.Event{events.BadgeAddedToEntity{
+		BadgeSlug:  "rookie",
+		EntityType: EntityTypeMoment,
+		EntityID:   1,
+		Metadata:   map[string]string{},
+	}}, latestEvents(t, b, contracts))
+
+	removeBadgeFromEntity(t, b, contracts, "rookie", EntityTypeMoment, 1, nil)
+	assert.Equal(t, []events.Event{events.BadgeRemovedFromEntity{
+		BadgeSlug:  "rookie",
+		EntityType: EntityTypeMoment,
+		EntityID:   1,
+	}}, latestEvents(t, b, contracts))
+
+	deleteBadge(t, b, contracts, "rookie", nil)
+	assert.Equal(t, []events.Event{events.BadgeDeleted{Slug: "rookie"}}, latestEvents(t, b, contracts))
+}
+
+func TestSubmit(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	client := contracts.client(b)
+
+	// signedCreateSeries returns a create_series transaction signed by the
+	// service account and the AllDay account
+	signedCreateSeries := func(name string) *flow.Transaction {
+		tx, err := contracts.builder().CreateSeries(name)
+		require.NoError(t, err)
+		tx.SetComputeLimit(100).
+			SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+			SetPayer(b.ServiceKey().Address).
+			AddAuthorizer(contracts.AllDayAddress)
+
+		require.NoError(t, tx.SignPayload(contracts.AllDayAddress, 0, contracts.AllDaySigner))
+		signer, err := b.ServiceKey().Signer()
+		require.NoError(t, err)
+		require.NoError(t, tx.SignEnvelope(b.ServiceKey().Address, 0, signer))
+		return tx
+	}
+
+	t.Run("Should return the sealed result with decoded events", func(t *testing.T) {
+		tx := signedCreateSeries("Series One")
+		result, err := client.Submit(context.Background(), tx)
+		require.NoError(t, err)
+
+		block, err := b.GetLatestBlock()
+		require.NoError(t, err)
+		assert.Equal(t, tx.ID(), result.ID)
+		assert.Equal(t, flow.TransactionStatusSealed, result.Status)
+		assert.Equal(t, block.Height, result.BlockHeight)
+		assert.Greater(t, result.ComputationUsed, uint64(0))
+		assert.Equal(t, []events.Event{events.SeriesCreated{ID: 1, Name: "Series One"}}, result.Events)
+		assert.True(t, result.Succeeded())
+	})
+
+	t.Run("Should return a transaction error when a precondition fails", func(t *testing.T) {
+		result, err := client.Submit(context.Background(), signedCreateSeries("Series One"))
+		require.NotNil(t, result)
+		assert.Equal(t, flow.TransactionStatusSealed, result.Status)
+		assert.Empty(t, result.Events)
+
+		assert.ErrorIs(t, err, allday.ErrDuplicateSeries)
+
+		var txErr *allday.TransactionError
+		require.ErrorAs(t, err, &txErr)
+		assert.Contains(t, txErr.Message, "A Series with that name already exists")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	client := contracts.client(b)
+	ctx := context.Background()
+
+	t.Run("Should accept an edition the contract accepts", func(t *testing.T) {
+		err := client.ValidateCreateEdition(ctx, 1, 2, 2, model.TierRare, nil, uint64Ptr(10))
+		require.NoError(t, err)
+		createEdition(t, b, contracts, 1, 2, 2, uint64Ptr(10), "RARE", nil, nil)
+	})
+
+	t.Run("Should report every violation of an edition the contract rejects", func(t *testing.T) {
+		// series 2 is closed and edition 1 has set 1, play 1 and tier COMMON
+		err := client.ValidateCreateEdition(ctx, 2, 1, 1, model.TierCommon, nil, nil)
+		assert.ErrorIs(t, err, allday.ErrSeriesClosed)
+		assert.ErrorIs(t, err, allday.ErrDuplicateEdition)
+
+		// the contract stops at the first failed precondition
+		createEdition(t, b, contracts, 2, 1, 1, nil, "COMMON", nil, allday.ErrSeriesClosed)
+	})
+
+	t.Run("Should report a mint the contract rejects", func(t *testing.T) {
+		otherAddress, _ := createAccount(t, b)
+		mintMomentNFT(t, b, contracts, userAddress, 1, nil, nil)
+		mintMomentNFT(t, b, contracts, userAddress, 1, nil, nil)
+
+		err := client.ValidateMintMomentNFT(ctx, otherAddress, 1)
+		assert.ErrorIs(t, err, allday.ErrEditionFull)
+		assert.ErrorIs(t, err, allday.ErrRecipientNotSetup)
+		// the transaction borrows the recipient's collection before minting
+		mintMomentNFT(t, b, contracts, otherAddress, 1, nil, allday.ErrRecipientNotSetup)
+
+		assert.ErrorIs(t, client.ValidateCloseEdition(ctx, 3), allday.ErrEditionFull)
+		closeEdition(t, b, contracts, 3, allday.ErrEditionFull)
+	})
+
+	t.Run("Should report badge operations the contract rejects", func(t *testing.T) {
+		require.NoError(t, client.ValidateCreateBadge(ctx, "rookie"))
+		createBadge(t, b, contracts, "rookie", "Rookie", "First season", true, "rookie-v2", nil)
+
+		assert.ErrorIs(t, client.ValidateCreateBadge(ctx, "rookie"), allday.ErrDuplicateBadge)
+		assert.ErrorIs(t, client.ValidateDeleteBadge(ctx, "veteran"), allday.ErrBadgeNotFound)
+		deleteBadge(t, b, contracts, "veteran", allday.ErrBadgeNotFound)
+
+		require.NoError(t, client.ValidateAddBadgeToEntity(ctx, "rookie", EntityTypeMoment, 1))
+		addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 1, map[string]string{}, nil)
+		slugs, err := client.GetEntityBadgeSlugs(ctx, EntityTypeMoment, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rookie"}, slugs)
+		assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", EntityTypeMoment, 1), allday.ErrBadgeAlreadyAdded)
+		addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 1, map[string]string{}, allday.ErrBadgeAlreadyAdded)
+
+		assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", EntityTypeEdition, 99), allday.ErrEditionNotFound)
+		assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "rookie", "team", 1), allday.ErrInvalidEntityType)
+		addBadgeToEntity(t, b, contracts, "rookie", "team", 1, map[string]string{}, allday.ErrInvalidEntityType)
+	})
+}
+
+func TestFindEditionID(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	createTestEditions(t, b, contracts)
+	client := contracts.client(b)
+	ctx := context.Background()
+
+	tests := []struct {
+		setID, playID uint64
+		tier          model.Tier
+		parallel      *model.Parallel
+		want          *uint64
+	}{
+		{1, 1, model.TierCommon, nil, uint64Ptr(1)},
+		{2, 1, model.TierCommon, nil, uint64Ptr(2)},
+		{1, 2, model.TierCommon, nil, uint64Ptr(3)},
+		{1, 2, model.TierCommon, model.ParallelStandard.Optional(), uint64Ptr(3)},
+		{1, 2, model.TierCommon, model.ParallelRuby.Optional(), uint64Ptr(4)},
+		{1, 2, model.TierLegendary, nil, uint64Ptr(5)},
+		{1, 2, model.TierLegendary, model.ParallelRuby.Optional(), nil},
+		{1, 1, model.TierRare, nil, nil},
+		{9, 1, model.TierCommon, nil, nil},
+	}
+
+	t.Run("Should find the edition with a set, play, tier and parallel", func(t *testing.T) {
+		for _, test := range tests {
+			id, err := client.FindEditionID(ctx, test.setID, test.playID, test.tier, test.parallel)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, id, "set %d, play %d, tier %s, parallel %v", test.setID, test.playID, test.tier, test.parallel)
+		}
+	})
+
+	t.Run("Should fail for an edition missing from the map", func(t *testing.T) {
+		removeEditionIDMap(t, b, contracts)
+		for _, test := range tests {
+			id, err := client.FindEditionID(ctx, test.setID, test.playID, test.tier, test.parallel)
+			if test.want == nil {
+				require.NoError(t, err)
+				assert.Nil(t, id)
+				continue
+			}
+			assert.ErrorIs(t, err, allday.ErrEditionIDMapNotMigrated, "set %d, play %d, tier %s, parallel %v", test.setID, test.playID, test.tier, test.parallel)
+		}
+		assert.ErrorIs(t, client.ValidateCreateEdition(ctx, 1, 1, 1, model.TierCommon, nil, nil), allday.ErrEditionIDMapNotMigrated)
+	})
+
+	t.Run("Should find every edition after inserting existing editions into the map", func(t *testing.T) {
+		insertEditionIDs(t, b, contracts, 1, 10, nil)
+		for _, test := range tests {
+			id, err := client.FindEditionID(ctx, test.setID, test.playID, test.tier, test.parallel)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, id, "set %d, play %d, tier %s, parallel %v", test.setID, test.playID, test.tier, test.parallel)
+		}
+	})
+}
+
+func TestListEditions(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	mintMomentNFT(t, b, contracts, userAddress, 1, nil, nil)
+	client := contracts.client(b, allday.WithPageSize(2))
+	ctx := context.Background()
+
+	ids := func(editions []model.Edition) []uint64 {
+		ids := make([]uint64, len(editions))
+		for i, edition := range editions {
+			ids[i] = edition.ID
+		}
+		return ids
+	}
+	open, closed := true, false
+	legendary, ruby := model.TierLegendary, model.ParallelRuby
+
+	t.Run("Should list every edition across pages", func(t *testing.T) {
+		editions, err := client.ListEditions(ctx, allday.EditionFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2, 3, 4, 5}, ids(editions))
+		for _, edition := range editions {
+			expected, err := client.GetEdition(ctx, edition.ID)
+			require.NoError(t, err)
+			assert.Equal(t, expected, edition)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		filter allday.EditionFilter
+		want   []uint64
+	}{
+		{"set", allday.EditionFilter{SetID: uint64Ptr(1)}, []uint64{1, 3, 4, 5}},
+		{"play and tier", allday.EditionFilter{PlayID: uint64Ptr(2), Tier: &legendary}, []uint64{5}},
+		{"parallel", allday.EditionFilter{Parallel: &ruby}, []uint64{4}},
+		{"open", allday.EditionFilter{Open: &open}, []uint64{1, 2, 4, 5}},
+		{"closed", allday.EditionFilter{Open: &closed}, []uint64{3}},
+		// edition 1 has minted 1 of 2, and editions 2, 4 and 5 have no max mint size
+		{"remaining supply", allday.EditionFilter{MinRemainingSupply: uint64Ptr(2)}, []uint64{2, 4, 5}},
+	}
+	for _, test := range tests {
+		t.Run("Should filter editions by "+test.name, func(t *testing.T) {
+			editions, err := client.ListEditions(ctx, test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, ids(editions))
+		})
+	}
+}
+
+func TestForEachMomentID(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	otherAddress, otherSigner := createAccount(t, b)
+	setupAllDay(t, b, otherAddress, otherSigner, contracts)
+	createTestEditions(t, b, contracts)
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{2, 2, 2, 2, 2, 2, 2}, make([]*uint64, 7), nil)
+	client := contracts.client(b, allday.WithPageSize(3))
+	ctx := context.Background()
+
+	t.Run("Should visit every moment across pages", func(t *testing.T) {
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, userAddress, func(id uint64) error {
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2, 3, 4, 5, 6, 7}, visited)
+	})
+
+	var withdrawn uint64
+	t.Run("Should visit every held moment once if the collection changes", func(t *testing.T) {
+		var visited []uint64
+		err := client.ForEachMomentID(ctx, userAddress, func(id uint64) error {
+			if len(visited) == 0 {
+				// withdraw a moment that was already visited
+				transferMomentNFT(t, b, contracts, userAddress, userSigner, id, otherAddress, nil)
+				withdrawn = id
+			}
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2, 3, 4, 5, 6, 7}, visited)
+	})
+
+	t.Run("Should visit every held moment if a withdrawal and a deposit keep the length", func(t *testing.T) {
+		var visited []uint64
+		var held []uint64
+		err := client.ForEachMomentID(ctx, userAddress, func(id uint64) error {
+			if len(visited) == 0 {
+				// swap the visited moment for the one withdrawn before
+				transferMomentNFT(t, b, contracts, userAddress, userSigner, id, otherAddress, nil)
+				transferMomentNFT(t, b, contracts, otherAddress, otherSigner, withdrawn, userAddress, nil)
+			} else if id != withdrawn {
+				held = append(held, id)
+			}
+			visited = append(visited, id)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, held, 5)
+		assert.Subset(t, visited, held)
+		assert.Len(t, visited, len(slices.Compact(slices.Sorted(slices.Values(visited)))))
+	})
+
+	t.Run("Should return ErrOwnerNotSetup for an account without a collection", func(t *testing.T) {
+		address, _ := createAccount(t, b)
+		err := client.ForEachMomentID(ctx, address, func(uint64) error { return nil })
+		assert.ErrorIs(t, err, allday.ErrOwnerNotSetup)
+	})
+}
+
+func TestGetMomentViews(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{1, 2, 4, 5}, make([]*uint64, 4), nil)
+
+	createBadge(t, b, contracts, "rookie", "Rookie", "First season", true, "rookie-v2", nil)
+	createBadge(t, b, contracts, "mvp", "MVP", "Most valuable player", false, "mvp-v2", nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 1, map[string]string{"from": "play"}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 2, map[string]string{"from": "moment"}, nil)
+	addBadgeToEntity(t, b, contracts, "mvp", EntityTypeEdition, 4, map[string]string{}, nil)
+
+	client := contracts.client(b, allday.WithPageSize(3))
+	ctx := context.Background()
+
+	views, err := client.GetMomentViews(ctx, userAddress, []uint64{4, 99, 3, 2, 1})
+	require.NoError(t, err)
+	require.Len(t, views, 4)
+
+	for i, id := range []uint64{4, 3, 2, 1} {
+		view := views[i]
+		moment, err := client.GetMoment(ctx, userAddress, id)
+		require.NoError(t, err)
+		edition, err := client.GetEdition(ctx, moment.EditionID)
+		require.NoError(t, err)
+		play, err := client.GetPlay(ctx, edition.PlayID)
+		require.NoError(t, err)
+		series, err := client.GetSeries(ctx, edition.SeriesID)
+		require.NoError(t, err)
+		set, err := client.GetSet(ctx, edition.SetID)
+		require.NoError(t, err)
+
+		assert.Equal(t, id, view.ID)
+		assert.Equal(t, moment.SerialNumber, view.SerialNumber)
+		assert.Equal(t, moment.MintingDate, view.MintingDate)
+		assert.Equal(t, edition, view.Edition)
+		assert.Equal(t, play, view.Play)
+		assert.Equal(t, series.Name, view.SeriesName)
+		assert.Equal(t, set.Name, view.SetName)
+		assert.ElementsMatch(t, getNftAllBadges(t, b, contracts, userAddress, id), view.Badges)
+	}
+	// moment 2 has the badge both itself and through its play
+	require.Len(t, views[2].Badges, 1)
+	assert.Equal(t, "rookie", views[2].Badges[0].Slug)
+	require.Len(t, views[1].Badges, 1)
+	assert.Equal(t, "mvp", views[1].Badges[0].Slug)
+	assert.Empty(t, views[0].Badges)
+}
+
+func TestRenderMomentMetadata(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+
+	// a catalog of plays with and without names and descriptions, across
+	// series and sets, in editions with and without a max mint size
+	createSeries(t, b, contracts, "Series A", nil)
+	createSeries(t, b, contracts, "Series B", nil)
+	for _, name := range []string{"Set 1", "Set 2", "Set 3"} {
+		createSet(t, b, contracts, name, nil)
+	}
+	plays := []map[string]string{
+		{"playerFirstName": "Apple", "playerLastName": "Alpha", "playType": "Interception", "description": "Diving interception"},
+		{"playerFirstName": "Bravo", "playerLastName": "Beta", "playType": "Touchdown"},
+		{"playType": "Safety", "teamName": "Charlie"},
+		{"playerFirstName": "Delta", "playerLastName": "Dog", "playType": "Sack", "description": ""},
+		{},
+	}
+	var momentIDs []uint64
+	for i, metadata := range plays {
+		playID := uint64(i + 1)
+		createPlay(t, b, contracts, "PLAYER_GAME", metadata, nil)
+		var maxMintSize *uint64
+		if playID%2 == 1 {
+			maxMintSize = uint64Ptr(3)
+		}
+		createEdition(t, b, contracts, playID%2+1, playID%3+1, playID, maxMintSize, "COMMON", nil, nil)
+		for range 2 {
+			mintMomentNFT(t, b, contracts, userAddress, playID, nil, nil)
+			momentIDs = append(momentIDs, uint64(len(momentIDs)+1))
+		}
+	}
+	closeEdition(t, b, contracts, 1, nil)
+
+	client := contracts.client(b)
+	renderer := render.NewRenderer(contracts.environment())
+	ctx := context.Background()
+
+	momentViews, err := client.GetMomentViews(ctx, userAddress, momentIDs)
+	require.NoError(t, err)
+	require.Len(t, momentViews, len(momentIDs))
+
+	for _, view := range momentViews {
+		want, err := client.GetMomentMetadata(ctx, userAddress, view.ID)
+		require.NoError(t, err)
+
+		got := renderer.MomentMetadata(render.MomentFromView(view))
+		assert.Equal(t, want.Display, got.Display, "moment %d", view.ID)
+		assert.Equal(t, want.Editions, got.Editions, "moment %d", view.ID)
+		assert.Equal(t, want.ExternalURL, got.ExternalURL, "moment %d", view.ID)
+		assert.Equal(t, want.Medias, got.Medias, "moment %d", view.ID)
+		assert.Equal(t, want.NFTCollectionDisplay, got.NFTCollectionDisplay, "moment %d", view.ID)
+		assert.Equal(t, want.Royalties, got.Royalties, "moment %d", view.ID)
+		assert.Equal(t, want.Serial, got.Serial, "moment %d", view.ID)
+		assert.Equal(t, sortedTraits(want.Traits), got.Traits, "moment %d", view.ID)
+		assert.Equal(t, want.NFTCollectionData, got.NFTCollectionData, "moment %d", view.ID)
+	}
+}
+
+// sortedTraits sorts traits by name and badges by slug, as Cadence orders
+// neither
+func sortedTraits(traits views.Traits) views.Traits {
+	sorted := slices.Clone(traits.Traits)
+	for i, trait := range sorted {
+		if badges, ok := trait.Value.([]model.Badge); ok {
+			badges = slices.Clone(badges)
+			slices.SortFunc(badges, func(a, b model.Badge) int { return strings.Compare(a.Slug, b.Slug) })
+			sorted[i].Value = badges
+		}
+	}
+	slices.SortFunc(sorted, func(a, b views.Trait) int { return strings.Compare(a.Name, b.Name) })
+	return views.Traits{Traits: sorted}
+}
+
+func TestRenderTraits(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestSeries(t, b, contracts)
+	createTestSets(t, b, contracts)
+
+	const seed = 20261018
+	t.Logf("seed %d", seed)
+	random := rand.New(rand.NewPCG(seed, seed))
+
+	// metadata names include the traits getTraits adds, which play metadata
+	// replaces
+	names := []string{
+		"playerFirstName", "playerLastName", "playType", "description", "teamName", "gameDate",
+		"homeTeamScore", "setName", "seriesName", "editionTier", "serialNumber", "badges",
+	}
+	slugs := []string{"rookie", "mvp", "playoffs"}
+	for _, slug := range slugs {
+		createBadge(t, b, contracts, slug, slug, slug+" badge", true, slug+"-v2", nil)
+	}
+	levels := []string{EntityTypePlay, EntityTypeEdition, EntityTypeMoment}
+
+	const moments = 8
+	var momentIDs []uint64
+	for id := uint64(1); id <= moments; id++ {
+		metadata := map[string]string{}
+		for _, name := range names {
+			switch random.IntN(3) {
+			case 0:
+				metadata[name] = fmt.Sprintf("%s %d", name, random.IntN(1000))
+			case 1:
+				metadata[name] = ""
+			}
+		}
+		createPlay(t, b, contracts, "PLAYER_GAME", metadata, nil)
+		createEdition(t, b, contracts, 1, 1, id, nil, "COMMON", nil, nil)
+		mintMomentNFT(t, b, contracts, userAddress, id, nil, nil)
+		momentIDs = append(momentIDs, id)
+
+		// the play, edition and moment each have the ID id
+		for _, slug := range slugs {
+			for _, level := range levels {
+				if random.IntN(3) == 0 {
+					addBadgeToEntity(t, b, contracts, slug, level, id, map[string]string{"level": level}, nil)
+				}
+			}
+		}
+	}
+
+	client := contracts.client(b)
+	ctx := context.Background()
+	momentViews, err := client.GetMomentViews(ctx, userAddress, momentIDs)
+	require.NoError(t, err)
+	require.Len(t, momentViews, moments)
+
+	for _, view := range momentViews {
+		want, err := client.GetMomentMetadata(ctx, userAddress, view.ID)
+		require.NoError(t, err)
+
+		got := render.MomentFromView(view).Traits()
+		assert.Equal(t, sortedTraits(want.Traits), got, "moment %d with play metadata %v", view.ID, view.Play.Metadata)
+	}
+}
+
+func TestBadgeIndex(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	// moments 1 and 2 are of edition 1 and play 1, 3 of edition 2 and play 1,
+	// and 4 of edition 4 and play 2
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{1, 1, 2, 4}, make([]*uint64, 4), nil)
+
+	// index applies the badge events of each badge transaction
+	index := render.NewBadgeIndex()
+	indexed := func() {
+		for _, event := range latestEvents(t, b, contracts) {
+			index.Apply(event)
+		}
+	}
+	for _, slug := range []string{"rookie", "mvp", "playoffs", "retired", "allpro"} {
+		createBadge(t, b, contracts, slug, slug, slug+" badge", true, slug+"-v2", nil)
+		indexed()
+	}
+	updateBadge(t, b, contracts, "rookie", stringPtr("First Season"), nil, nil, nil, map[string]string{"year": "2021"}, nil)
+	indexed()
+
+	// rookie is attached at every level of moment 1 up to its play, and allpro
+	// at its moment, play, set and series
+	for _, attachment := range []struct {
+		slug       string
+		entityType string
+		entityID   uint64
+	}{
+		{"rookie", EntityTypeMoment, 1},
+		{"rookie", EntityTypeEdition, 1},
+		{"rookie", EntityTypePlay, 1},
+		{"mvp", EntityTypeEdition, 2},
+		{"mvp", EntityTypeMoment, 2},
+		{"playoffs", EntityTypeMoment, 4},
+		{"playoffs", EntityTypePlay, 2},
+		{"retired", EntityTypePlay, 2},
+		{"allpro", EntityTypeMoment, 1},
+		{"allpro", EntityTypePlay, 1},
+		{"allpro", EntityTypeSet, 1},
+		{"allpro", EntityTypeSeries, 1},
+	} {
+		addBadgeToEntity(t, b, contracts, attachment.slug, attachment.entityType, attachment.entityID, map[string]string{"from": attachment.entityType}, nil)
+		indexed()
+	}
+	removeBadgeFromEntity(t, b, contracts, "playoffs", EntityTypePlay, 2, nil)
+	indexed()
+	deleteBadge(t, b, contracts, "retired", nil)
+	indexed()
+
+	client := contracts.client(b)
+	editions := map[uint64]model.Edition{}
+	for _, id := range []uint64{1, 2, 4} {
+		edition, err := client.GetEdition(context.Background(), id)
+		require.NoError(t, err)
+		editions[id] = edition
+	}
+	moments := []struct {
+		id      uint64
+		edition model.Edition
+	}{{1, editions[1]}, {2, editions[1]}, {3, editions[2]}, {4, editions[4]}}
+
+	// resolvesLikeScript checks the index resolves the badges get_nft_all_badges
+	// returns, through attachments to entities that have the badge
+	resolvesLikeScript := func(t *testing.T) {
+		for _, moment := range moments {
+			badges := getNftAllBadges(t, b, contracts, userAddress, moment.id)
+			slices.SortFunc(badges, func(a, b model.Badge) int { return strings.Compare(a.Slug, b.Slug) })
+			assert.Equal(t, badges, index.MomentBadges(moment.id, moment.edition), "moment %d", moment.id)
+
+			for slug, attachments := range index.MomentAttachments(moment.id, moment.edition) {
+				for _, attachment := range attachments {
+					slugs, err := client.GetEntityBadgeSlugs(context.Background(), attachment.EntityType, attachment.EntityID)
+					require.NoError(t, err)
+					assert.Contains(t, slugs, slug, "%s %d", attachment.EntityType, attachment.EntityID)
+				}
+			}
+		}
+	}
+
+	// levels returns the entity types of the attachments of slug to a moment
+	levels := func(momentID uint64, edition model.Edition, slug string) []string {
+		var levels []string
+		for _, attachment := range index.MomentAttachments(momentID, edition)[slug] {
+			levels = append(levels, attachment.EntityType)
+		}
+		return levels
+	}
+
+	t.Run("Should resolve the badges get_nft_all_badges returns", resolvesLikeScript)
+
+	t.Run("Should list the attachments of a slug attached at several levels", func(t *testing.T) {
+		attachments := index.MomentAttachments(1, editions[1])
+		require.Len(t, attachments, 2)
+		assert.Equal(t, []render.BadgeAttachment{
+			{Slug: "rookie", EntityType: EntityTypeMoment, EntityID: 1, Metadata: map[string]string{"from": EntityTypeMoment}},
+			{Slug: "rookie", EntityType: EntityTypeEdition, EntityID: 1, Metadata: map[string]string{"from": EntityTypeEdition}},
+			{Slug: "rookie", EntityType: EntityTypePlay, EntityID: 1, Metadata: map[string]string{"from": EntityTypePlay}},
+		}, attachments["rookie"])
+		assert.Equal(t, []string{EntityTypeMoment, EntityTypePlay, EntityTypeSet, EntityTypeSeries}, levels(1, editions[1], "allpro"))
+		assert.Equal(t, []string{EntityTypeSet, EntityTypeSeries}, levels(4, editions[4], "allpro"))
+		assert.Equal(t, "First Season", index.Badge("rookie").Title)
+	})
+
+	t.Run("Should skip removed attachments and deleted badges", func(t *testing.T) {
+		attachments := index.MomentAttachments(4, editions[4])
+		require.Len(t, attachments, 2)
+		assert.Equal(t, []string{EntityTypeMoment}, levels(4, editions[4], "playoffs"))
+	})
+
+	t.Run("Should drop the attachments of a slug removed from the series and set", func(t *testing.T) {
+		removeBadgeFromEntity(t, b, contracts, "allpro", EntityTypeSeries, 1, nil)
+		indexed()
+		resolvesLikeScript(t)
+		assert.Equal(t, []string{EntityTypeMoment, EntityTypePlay, EntityTypeSet}, levels(1, editions[1], "allpro"))
+
+		removeBadgeFromEntity(t, b, contracts, "allpro", EntityTypeSet, 1, nil)
+		indexed()
+		resolvesLikeScript(t)
+		assert.Equal(t, []string{EntityTypeMoment, EntityTypePlay}, levels(1, editions[1], "allpro"))
+		assert.NotContains(t, index.MomentAttachments(4, editions[4]), "allpro")
+	})
+}
+
+func TestBadgeLookups(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	client := contracts.client(b)
+	ctx := context.Background()
+
+	t.Run("Should list no badges before any are created", func(t *testing.T) {
+		badges, err := client.AllBadges(ctx, false)
+		require.NoError(t, err)
+		assert.Empty(t, badges)
+	})
+
+	createTestEditions(t, b, contracts)
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{1, 2}, make([]*uint64, 2), nil)
+	createBadge(t, b, contracts, "rookie", "Rookie", "First season", true, "rookie-v2", nil)
+	createBadge(t, b, contracts, "mvp", "MVP", "Most valuable player", false, "mvp-v2", nil)
+	createBadge(t, b, contracts, "retired", "Retired", "Last season", true, "retired-v2", nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 2, map[string]string{"season": "2021"}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 1, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeEdition, 4, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 2, map[string]string{"note": "signed"}, nil)
+	addBadgeToEntity(t, b, contracts, "mvp", EntityTypeMoment, 1, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeEdition, 5, map[string]string{}, nil)
+	removeBadgeFromEntity(t, b, contracts, "rookie", EntityTypeEdition, 5, nil)
+	addBadgeToEntity(t, b, contracts, "retired", EntityTypePlay, 1, map[string]string{}, nil)
+	deleteBadge(t, b, contracts, "retired", nil)
+
+	t.Run("Should list every badge", func(t *testing.T) {
+		badges, err := client.AllBadges(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, badges, 2)
+		assert.Equal(t, "mvp", badges[0].Slug)
+		assert.False(t, badges[0].Visible)
+		assert.Equal(t, "rookie", badges[1].Slug)
+	})
+
+	t.Run("Should list only visible badges", func(t *testing.T) {
+		badges, err := client.AllBadges(ctx, true)
+		require.NoError(t, err)
+		require.Len(t, badges, 1)
+		assert.Equal(t, "rookie", badges[0].Slug)
+	})
+
+	t.Run("Should look up the entities a badge is attached to", func(t *testing.T) {
+		entities, err := client.GetBadgeEntities(ctx, "rookie")
+		require.NoError(t, err)
+		assert.Equal(t, []model.BadgeEntity{
+			{EntityType: EntityTypeEdition, EntityID: 4, Metadata: map[string]string{}},
+			{EntityType: EntityTypeMoment, EntityID: 2, Metadata: map[string]string{"note": "signed"}},
+			{EntityType: EntityTypePlay, EntityID: 1, Metadata: map[string]string{}},
+			{EntityType: EntityTypePlay, EntityID: 2, Metadata: map[string]string{"season": "2021"}},
+		}, entities)
+	})
+
+	t.Run("Should look up the entities of a deleted badge", func(t *testing.T) {
+		entities, err := client.GetBadgeEntities(ctx, "retired")
+		require.NoError(t, err)
+		assert.Equal(t, []model.BadgeEntity{{EntityType: EntityTypePlay, EntityID: 1, Metadata: map[string]string{}}}, entities)
+	})
+
+	t.Run("Should look up no entities for an unknown badge", func(t *testing.T) {
+		entities, err := client.GetBadgeEntities(ctx, "unknown")
+		require.NoError(t, err)
+		assert.Empty(t, entities)
+	})
+}
+
+func TestIndexBadgeEntities(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{1, 2}, make([]*uint64, 2), nil)
+	createBadge(t, b, contracts, "rookie", "Rookie", "Rookie season", true, "rookie-v2", nil)
+	client := contracts.client(b)
+	ctx := context.Background()
+
+	// attach the badges with a contract that doesn't index them, as they were
+	// attached before the index existed, then upgrade to the contract
+	code := contracts.allDayCode()
+	unindexed := bytes.Replace(code, []byte("self.indexBadgeEntity(badgeSlug, entityType, entityID, metadata)"), nil, 1)
+	require.NotEqual(t, code, unindexed)
+	updateAllDayContract(t, b, contracts, unindexed)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 1, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 2, map[string]string{"season": "2021"}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypePlay, 3, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeEdition, 4, map[string]string{}, nil)
+	addBadgeToEntity(t, b, contracts, "rookie", EntityTypeMoment, 2, map[string]string{"note": "signed"}, nil)
+	updateAllDayContract(t, b, contracts, code)
+
+	indexed := []model.BadgeEntity{
+		{EntityType: EntityTypeEdition, EntityID: 4, Metadata: map[string]string{}},
+		{EntityType: EntityTypeMoment, EntityID: 2, Metadata: map[string]string{"note": "signed"}},
+		{EntityType: EntityTypePlay, EntityID: 1, Metadata: map[string]string{}},
+		{EntityType: EntityTypePlay, EntityID: 2, Metadata: map[string]string{"season": "2021"}},
+		{EntityType: EntityTypePlay, EntityID: 3, Metadata: map[string]string{}},
+	}
+
+	t.Run("Should not look up badges attached before the index", func(t *testing.T) {
+		entities, err := client.GetBadgeEntities(ctx, "rookie")
+		require.NoError(t, err)
+		assert.Empty(t, entities)
+	})
+
+	t.Run("Should index the badges attached before the index in batches", func(t *testing.T) {
+		indexBadgeEntities(t, b, contracts, EntityTypePlay, 0, 2, nil)
+		entities, err := client.GetBadgeEntities(ctx, "rookie")
+		require.NoError(t, err)
+		assert.Len(t, entities, 2)
+
+		indexBadgeEntities(t, b, contracts, EntityTypePlay, 2, 2, nil)
+		indexBadgeEntities(t, b, contracts, EntityTypeEdition, 0, 2, nil)
+		indexBadgeEntities(t, b, contracts, EntityTypeMoment, 0, 2, nil)
+		entities, err = client.GetBadgeEntities(ctx, "rookie")
+		require.NoError(t, err)
+		assert.Equal(t, indexed, entities)
+	})
+
+	t.Run("Should leave the index unchanged when entities are indexed again", func(t *testing.T) {
+		indexBadgeEntities(t, b, contracts, EntityTypePlay, 0, 10, nil)
+		indexBadgeEntities(t, b, contracts, EntityTypeMoment, 5, 10, nil)
+
+		entities, err := client.GetBadgeEntities(ctx, "rookie")
+		require.NoError(t, err)
+		assert.Equal(t, indexed, entities)
+	})
+
+	t.Run("Should fail for entity types without a badge map to index", func(t *testing.T) {
+		indexBadgeEntities(t, b, contracts, EntityTypeSet, 0, 10, allday.ErrInvalidEntityType)
+		indexBadgeEntities(t, b, contracts, "team", 0, 10, allday.ErrInvalidEntityType)
+	})
+}
+
+func TestSetAndSeriesBadges(t *testing.T) {
+	b := newEmulator()
+	contracts := AllDayDeployContracts(t, b)
+	userAddress, userSigner := createAccount(t, b)
+	setupAllDay(t, b, userAddress, userSigner, contracts)
+	createTestEditions(t, b, contracts)
+	// moment 1 is of edition 1 in set 1, and moment 2 of edition 2 in set 2,
+	// both in series 1
+	mintMomentNFTMulti(t, b, contracts, userAddress, []uint64{1, 2}, make([]*uint64, 2), nil)
+	createBadge(t, b, contracts, "playoffs", "Playoffs", "Playoff moment", true, "playoffs-v2", nil)
+	createBadge(t, b, contracts, "launch", "Launch", "Launch series", true, "launch-v2", nil)
+	client := contracts.client(b)
+	ctx := context.Background()
+
+	slugs := func(badges []model.Badge) []string {
+		var slugs []string
+		for _, badge := range badges {
+			slugs = append(slugs, badge.Slug)
+		}
+		slices.Sort(slugs)
+		return slugs
+	}
+
+	t.Run("Should be able to add a badge to a set", func(t *testing.T) {
+		require.NoError(t, client.ValidateAddBadgeToEntity(ctx, "playoffs", EntityTypeSet, 1))
+		addBadgeToEntity(t, b, contracts, "playoffs", EntityTypeSet, 1, map[string]string{"round": "wildcard"}, nil)
+		assert.Equal(t, []events.Event{events.BadgeAddedToEntity{
+			BadgeSlug:  "playoffs",
+			EntityType: EntityTypeSet,
+			EntityID:   1,
+			Metadata:   map[string]string{"round": "wildcard"},
+		}}, latestEvents(t, b, contracts))
+	})
+
+	t.Run("Should be able to add a badge to a series", func(t *testing.T) {
+		require.NoError(t, client.ValidateAddBadgeToEntity(ctx, "launch", EntityTypeSeries, 1))
+		addBadgeToEntity(t, b, contracts, "launch", EntityTypeSeries, 1, map[string]string{}, nil)
+	})
+
+	t.Run("Should NOT be able to add a badge to a set or series twice", func(t *testing.T) {
+		addBadgeToEntity(t, b, contracts, "playoffs", EntityTypeSet, 1, map[string]string{},
+			revertsWith(allday.ErrBadgeAlreadyAdded, "badge slug already added to set"))
+		addBadgeToEntity(t, b, contracts, "launch", EntityTypeSeries, 1, map[string]string{},
+			revertsWith(allday.ErrBadgeAlreadyAdded, "badge slug already added to series"))
+	})
+
+	t.Run("Should report sets and series that do not exist", func(t *testing.T) {
+		assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "playoffs", EntityTypeSet, 99), allday.ErrSetNotFound)
+		assert.ErrorIs(t, client.ValidateAddBadgeToEntity(ctx, "playoffs", EntityTypeSeries, 99), allday.ErrSeriesNotFound)
+	})
+
+	t.Run("Should inherit set and series badges", func(t *testing.T) {
+		assert.Equal(t, []string{"launch", "playoffs"}, slugs(getNftAllBadges(t, b, contracts, userAddress, 1)))
+		assert.Equal(t, []string{"launch"}, slugs(getNftAllBadges(t, b, contracts, userAddress, 2)))
+
+		metadata, err := client.GetMomentMetadata(ctx, userAddress, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"launch", "playoffs"}, slugs(metadata.Traits.Map()["badges"].([]model.Badge)))
+	})
+
+	t.Run("Should merge a slug attached to the moment, play, set and series once", func(t *testing.T) {
+		addBadgeToEntity(t, b, contracts, "playoffs", EntityTypeMoment, 1, map[string]string{}, nil)
+		addBadgeToEntity(t, b, contracts, "playoffs", EntityTypePlay, 1, map[string]string{}, nil)
+		addBadgeToEntity(t, b, contracts, "playoffs", EntityTypeSeries, 1, map[string]string{}, nil)
+		assert.Equal(t, []string{"launch", "playoffs"}, slugs(getNftAllBadges(t, b, contracts, userAddress, 1)))
+		// moment 2 has the badge through its play and series
+		assert.Equal(t, []string{"launch", "playoffs"}, slugs(getNftAllBadges(t, b, contracts, userAddress, 2)))
+	})
+
+	t.Run("Should look up the sets and series a badge is attached to", func(t *testing.T) {
+		entities, err := client.GetBadgeEntities(ctx, "playoffs")
+		require.NoError(t, err)
+		assert.Equal(t, []model.BadgeEntity{
+			{EntityType: EntityTypeMoment, EntityID: 1, Metadata: map[string]string{}},
+			{EntityType: EntityTypePlay, EntityID: 1, Metadata: map[string]string{}},
+			{EntityType: EntityTypeSeries, EntityID: 1, Metadata: map[string]string{}},
+			{EntityType: EntityTypeSet, EntityID: 1, Metadata: map[string]string{"round": "wildcard"}},
+		}, entities)
+	})
+
+	t.Run("Should be able to remove a badge from a set and a series", func(t *testing.T) {
+		require.NoError(t, client.ValidateRemoveBadgeFromEntity(ctx, "launch", EntityTypeSeries, 1))
+		removeBadgeFromEntity(t, b, contracts, "launch", EntityTypeSeries, 1, nil)
+		assert.Equal(t, []events.Event{events.BadgeRemovedFromEntity{
+			BadgeSlug:  "launch",
+			EntityType: EntityTypeSeries,
+			EntityID:   1,
+		}}, latestEvents(t, b, contracts))
+		removeBadgeFromEntity(t, b, contracts, "playoffs", EntityTypeSet, 1, nil)
+
+		// removing a badge a set does not have emits no event
+		removeBadgeFromEntity(t, b, contracts, "playoffs", EntityTypeSet, 2, nil)
+		assert.Empty(t, latestEvents(t, b, contracts))
+
+		assert.Equal(t, []string{"playoffs"}, slugs(getNftAllBadges(t, b, contracts, userAddress, 1)))
+		entities, err := client.GetBadgeEntities(ctx, "launch")
+		require.NoError(t, err)
+		assert.Empty(t, entities)
+	})
+}
+
 func uint64Ptr(i uint64) *uint64 {
 	return &i
 }
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/convert"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/allday"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+)
+
+// EmulatorBackend is an allday.Backend backed by an in-process emulator
+type EmulatorBackend struct {
+	blockchain *emulator.Blockchain
+
+	// the emulator doesn't store the computation a transaction used, so it
+	// is kept from its execution
+	mu              sync.Mutex
+	computationUsed map[flow.Identifier]uint64
+}
+
+var _ allday.Backend = (*EmulatorBackend)(nil)
+
+// NewEmulatorBackend returns a backend that runs scripts and transactions on b
+func NewEmulatorBackend(b *emulator.Blockchain) *EmulatorBackend {
+	return &EmulatorBackend{
+		blockchain:      b,
+		computationUsed: map[flow.Identifier]uint64{},
+	}
+}
+
+// ExecuteScript executes script against the emulator's latest block
+func (e *EmulatorBackend) ExecuteScript(ctx context.Context, script builders.Script) (cadence.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	arguments, err := script.EncodedArguments()
+	if err != nil {
+		return nil, err
+	}
+	result, err := e.blockchain.ExecuteScript(script.Code, arguments)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Value, nil
+}
+
+// SendTransaction executes tx in its own block and commits it, so that it is
+// sealed when SendTransaction returns
+func (e *EmulatorBackend) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := e.blockchain.AddTransaction(*convert.SDKTransactionToFlow(tx)); err != nil {
+		return err
+	}
+	result, err := e.blockchain.ExecuteNextTransaction()
+	if err != nil {
+		return err
+	}
+	if _, err := e.blockchain.CommitBlock(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.computationUsed[result.TransactionID] = result.ComputationUsed
+	e.mu.Unlock()
+	return nil
+}
+
+// GetTransactionResult returns the emulator's result for the transaction
+// with id
+func (e *EmulatorBackend) GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	flowResult, err := e.blockchain.GetTransactionResult(convert.SDKIdentifierToFlow(id))
+	if err != nil {
+		return nil, err
+	}
+	result, err := convert.FlowTransactionResultToSDK(flowResult)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	result.ComputationUsage = e.computationUsed[id]
+	e.mu.Unlock()
+	return result, nil
+}
+
+// client returns an allday.Client that reads from and submits to b
+func (contracts Contracts) client(b *emulator.Blockchain, options ...allday.Option) *allday.Client {
+	return allday.NewClient(NewEmulatorBackend(b), contracts.environment(), options...)
+}
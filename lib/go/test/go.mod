@@ -3,10 +3,12 @@ module github.com/dapperlabs/nfl-smart-contracts/lib/go/test
 go 1.25.1
 
 require (
+	github.com/dapperlabs/nfl-smart-contracts v0.0.0
 	github.com/onflow/cadence v1.9.7
 	github.com/onflow/flow-emulator v1.16.3
 	github.com/onflow/flow-ft/lib/go/contracts v1.0.1
 	github.com/onflow/flow-ft/lib/go/templates v1.0.1
+	github.com/onflow/flow-go v0.45.0-internal-rc.3.0.20260129222115-cc0505f2afd5
 	github.com/onflow/flow-go-sdk v1.9.13
 	github.com/onflow/flow-nft/lib/go/contracts v1.3.0
 	github.com/rs/zerolog v1.34.0
@@ -133,7 +135,6 @@ require (
 	github.com/onflow/flow-core-contracts/lib/go/contracts v1.9.2 // indirect
 	github.com/onflow/flow-core-contracts/lib/go/templates v1.9.2 // indirect
 	github.com/onflow/flow-evm-bridge v0.1.0 // indirect
-	github.com/onflow/flow-go v0.45.0-internal-rc.3.0.20260129222115-cc0505f2afd5 // indirect
 	github.com/onflow/flow-nft/lib/go/templates v1.3.0 // indirect
 	github.com/onflow/flow/protobuf/go/flow v0.4.19 // indirect
 	github.com/onflow/go-ethereum v1.15.10 // indirect
@@ -219,3 +220,5 @@ require (
 	modernc.org/memory v1.11.0 // indirect
 	modernc.org/sqlite v1.44.3 // indirect
 )
+
+replace github.com/dapperlabs/nfl-smart-contracts => ../../..
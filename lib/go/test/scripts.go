@@ -1,13 +1,16 @@
 package test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/onflow/cadence"
-	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-emulator/emulator"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/views"
 )
 
 // Accounts
@@ -17,8 +20,7 @@ func accountIsSetup(
 	contracts Contracts,
 	address flow.Address,
 ) bool {
-	script := loadAllDayAccountIsSetupScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.BytesToAddress(address.Bytes()))})
+	result := executeBuiltScript(t, b, contracts.builder().AccountIsSetup(address))
 
 	return bool(result.(cadence.Bool))
 }
@@ -28,11 +30,10 @@ func getSeriesData(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	id uint64,
-) SeriesData {
-	script := loadAllDayReadSeriesByIDScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.UInt64(id))})
-
-	return parseSeriesData(result)
+) model.Series {
+	series, err := contracts.client(b).GetSeries(context.Background(), id)
+	require.NoError(t, err)
+	return series
 }
 
 func getSetData(
@@ -40,11 +41,10 @@ func getSetData(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	id uint64,
-) SetData {
-	script := loadAllDayReadSetByIDScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.UInt64(id))})
-
-	return parseSetData(result)
+) model.Set {
+	set, err := contracts.client(b).GetSet(context.Background(), id)
+	require.NoError(t, err)
+	return set
 }
 
 func getPlayData(
@@ -52,11 +52,10 @@ func getPlayData(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	id uint64,
-) PlayData {
-	script := loadAllDayReadPlayByIDScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.UInt64(id))})
-
-	return parsePlayData(result)
+) model.Play {
+	play, err := contracts.client(b).GetPlay(context.Background(), id)
+	require.NoError(t, err)
+	return play
 }
 
 func getEditionData(
@@ -64,11 +63,10 @@ func getEditionData(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	id uint64,
-) EditionData {
-	script := loadAllDayReadEditionByIDScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.UInt64(id))})
-
-	return parseEditionData(result)
+) model.Edition {
+	edition, err := contracts.client(b).GetEdition(context.Background(), id)
+	require.NoError(t, err)
+	return edition
 }
 
 func getMomentNFTSupply(
@@ -76,10 +74,9 @@ func getMomentNFTSupply(
 	b *emulator.Blockchain,
 	contracts Contracts,
 ) uint64 {
-	script := loadAllDayReadMomentNFTSupplyScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{})
-
-	return uint64(result.(cadence.UInt64))
+	supply, err := contracts.client(b).TotalSupply(context.Background())
+	require.NoError(t, err)
+	return supply
 }
 
 func getMomentNFTProperties(
@@ -88,34 +85,22 @@ func getMomentNFTProperties(
 	contracts Contracts,
 	collectionAddress flow.Address,
 	nftID uint64,
-) OurNFTData {
-	script := loadAllDayReadMomentNFTPropertiesScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{
-		jsoncdc.MustEncode(cadence.BytesToAddress(collectionAddress.Bytes())),
-		jsoncdc.MustEncode(cadence.UInt64(nftID)),
-	})
-
-	return parseNFTProperties(result)
+) model.Moment {
+	moment, err := contracts.client(b).GetMoment(context.Background(), collectionAddress, nftID)
+	require.NoError(t, err)
+	return moment
 }
 
-func getMomentNFTMetadata(t *testing.T,
+func getMomentNFTMetadata(
+	t *testing.T,
 	b *emulator.Blockchain,
 	contracts Contracts,
 	address flow.Address,
 	nftID uint64,
-	shouldRevert bool,
-) []cadence.Struct {
-	script := loadAllDayReadMomentNFTMetadataScript(contracts)
-	result := executeScriptAndCheck(t, b, script,
-		[][]byte{jsoncdc.MustEncode(cadence.BytesToAddress(address.Bytes())), jsoncdc.MustEncode(cadence.UInt64(nftID))})
-
-	cArray := result.(cadence.Array).Values
-	resultArray := make([]cadence.Struct, len(cArray))
-	for i, val := range cArray {
-		resultArray[i] = val.(cadence.Struct)
-	}
-
-	return resultArray
+) views.MomentMetadata {
+	metadata, err := contracts.client(b).GetMomentMetadata(context.Background(), address, nftID)
+	require.NoError(t, err)
+	return metadata
 }
 
 // Badges
@@ -124,20 +109,10 @@ func getBadgeBySlug(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	slug string,
-) *BadgeData {
-	script := loadAllDayGetBadgeBySlugScript(contracts)
-	slugStr, err := cadence.NewString(slug)
+) *model.Badge {
+	badge, err := contracts.client(b).GetBadge(context.Background(), slug)
 	require.NoError(t, err)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(slugStr)})
-
-	if optional, ok := result.(cadence.Optional); ok {
-		if optional.Value == nil {
-			return nil
-		}
-		badge := parseBadgeData(optional.Value)
-		return &badge
-	}
-	return nil
+	return badge
 }
 
 func getNftAllBadges(
@@ -146,14 +121,12 @@ func getNftAllBadges(
 	contracts Contracts,
 	account flow.Address,
 	nftID uint64,
-) []BadgeData {
-	script := loadAllDayGetNftAllBadgesScript(contracts)
-	result := executeScriptAndCheck(t, b, script, [][]byte{
-		jsoncdc.MustEncode(cadence.BytesToAddress(account.Bytes())),
-		jsoncdc.MustEncode(cadence.UInt64(nftID)),
-	})
-
-	return parseBadgeArray(result)
+) []model.Badge {
+	result := executeBuiltScript(t, b, contracts.builder().GetNFTAllBadges(account, nftID))
+
+	badges, err := model.DecodeBadges(result)
+	require.NoError(t, err)
+	return badges
 }
 
 func badgeExists(
@@ -162,10 +135,7 @@ func badgeExists(
 	contracts Contracts,
 	slug string,
 ) bool {
-	script := loadAllDayBadgeExistsScript(contracts)
-	slugStr, err := cadence.NewString(slug)
-	require.NoError(t, err)
-	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(slugStr)})
+	result := executeBuiltScript(t, b, contracts.builder().BadgeExists(slug))
 
 	return bool(result.(cadence.Bool))
 }
@@ -2,11 +2,12 @@ package test
 
 import (
 	"context"
-	"io/ioutil"
+	"errors"
+	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/onflow/flow-emulator/adapters"
-	"github.com/onflow/flow-emulator/convert"
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/cadence"
@@ -16,11 +17,17 @@ import (
 	"github.com/onflow/flow-go-sdk/crypto"
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
 	"github.com/onflow/flow-go-sdk/test"
+	flowgo "github.com/onflow/flow-go/model/flow"
 	nftcontracts "github.com/onflow/flow-nft/lib/go/contracts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/allday"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/builders"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/templates"
 )
 
 const (
@@ -42,6 +49,7 @@ type Contracts struct {
 	NFTAddress                      flow.Address
 	AllDayAddress                   flow.Address
 	MetadataViewsAddress            flow.Address
+	ViewResolverAddress             flow.Address
 	RoyaltyAddress                  flow.Address
 	FungibleTokenSwitchboardAddress flow.Address
 	AllDaySigner                    crypto.Signer
@@ -163,7 +171,7 @@ func AllDayDeployContracts(t *testing.T, b *emulator.Blockchain) Contracts {
 	)
 
 	tx1.
-		SetComputeLimit(400).
+		SetComputeLimit(500).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address)
 
@@ -173,7 +181,7 @@ func AllDayDeployContracts(t *testing.T, b *emulator.Blockchain) Contracts {
 		t, b, tx1,
 		[]flow.Address{b.ServiceKey().Address, AllDayAddress},
 		[]crypto.Signer{signer, AllDaySigner},
-		false,
+		nil,
 	)
 
 	_, err = b.CommitBlock()
@@ -183,14 +191,15 @@ func AllDayDeployContracts(t *testing.T, b *emulator.Blockchain) Contracts {
 		NFTAddress:                      nftAddress,
 		AllDayAddress:                   AllDayAddress,
 		MetadataViewsAddress:            mvAddress,
+		ViewResolverAddress:             viewResolverAddress,
 		FungibleTokenSwitchboardAddress: ftSwitchboardAddress,
 		RoyaltyAddress:                  royaltyAddress,
 		AllDaySigner:                    AllDaySigner,
 	}
 
-	royaltySetupTx := flow.NewTransaction().
-		SetScript(loadSetupSwitchboardAccountTransaction(contracts)).
-		SetComputeLimit(100).
+	royaltySetupTx, err := contracts.builder().SetupSwitchboardAccount()
+	require.NoError(t, err)
+	royaltySetupTx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(royaltyAddress)
@@ -201,12 +210,40 @@ func AllDayDeployContracts(t *testing.T, b *emulator.Blockchain) Contracts {
 		t, b, royaltySetupTx,
 		[]flow.Address{b.ServiceKey().Address, royaltyAddress},
 		[]crypto.Signer{signer, AllDaySigner},
-		false,
+		nil,
 	)
 
 	return contracts
 }
 
+// updateAllDayContract replaces the deployed AllDay contract with code
+func updateAllDayContract(t *testing.T, b *emulator.Blockchain, contracts Contracts, code []byte) {
+	tx := sdktemplates.UpdateAccountContract(
+		contracts.AllDayAddress,
+		sdktemplates.Contract{
+			Name:   "AllDay",
+			Source: string(code),
+		},
+	)
+
+	tx.
+		SetComputeLimit(500).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address)
+
+	signer, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
+		[]crypto.Signer{signer, contracts.AllDaySigner},
+		nil,
+	)
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+}
+
 // newEmulator returns a emulator object for testing
 func newEmulator() *emulator.Blockchain {
 	b, err := emulator.New(emulator.WithStorageLimitEnabled(false))
@@ -219,16 +256,16 @@ func newEmulator() *emulator.Blockchain {
 // signAndSubmit signs a transaction with an array of signers and adds their signatures to the transaction
 // Then submits the transaction to the emulator. If the private keys don't match up with the addresses,
 // the transaction will not succeed.
-// shouldRevert parameter indicates whether the transaction should fail or not
-// This function asserts the correct result and commits the block if it passed
+// expectedErr is the error the transaction should fail with, or nil if it should succeed
+// This function asserts the correct result and returns it
 func signAndSubmit(
 	t *testing.T,
 	b *emulator.Blockchain,
 	tx *flow.Transaction,
 	signerAddresses []flow.Address,
 	signers []crypto.Signer,
-	shouldRevert bool,
-) {
+	expectedErr error,
+) *allday.TxResult {
 	// sign transaction with each signer
 	for i := len(signerAddresses) - 1; i >= 0; i-- {
 		signerAddress := signerAddresses[i]
@@ -243,35 +280,65 @@ func signAndSubmit(
 		}
 	}
 
-	submit(t, b, tx, shouldRevert)
+	return submit(t, b, tx, expectedErr)
 }
 
-// submit submits a transaction and checks
-// if it fails or not
+// submit submits a transaction and waits for its result, checking
+// it fails with expectedErr, or succeeds if expectedErr is nil
 func submit(
 	t *testing.T,
 	b *emulator.Blockchain,
 	tx *flow.Transaction,
-	shouldRevert bool,
-) {
-	// submit the signed transaction
-	flowTx := convert.SDKTransactionToFlow(*tx)
-	err := b.AddTransaction(*flowTx)
-	require.NoError(t, err)
+	expectedErr error,
+) *allday.TxResult {
+	// events are left undecoded, as the contract addresses aren't known here
+	client := allday.NewClient(NewEmulatorBackend(b), templates.Environment{})
+	result, err := client.Submit(context.Background(), tx)
+	require.NotNil(t, result, "transaction was not sealed: %v", err)
+
+	assertTxError(t, expectedErr, err)
+	return result
+}
 
-	result, err := b.ExecuteNextTransaction()
-	require.NoError(t, err)
+// revert is the expected failure of a transaction that reverts
+type revert struct {
+	// kind is the error the failure is classified into, such as
+	// allday.ErrEditionFull
+	kind error
+	// message is the panic or precondition message the transaction
+	// reverts with
+	message string
+}
+
+func (r revert) Error() string {
+	return fmt.Sprintf("%v: %s", r.kind, r.message)
+}
 
-	if shouldRevert {
-		assert.True(t, result.Reverted())
-	} else {
-		if !assert.True(t, result.Succeeded()) {
-			t.Log(result.Error.Error())
+// revertsWith returns the expected failure of a transaction that reverts
+// with message, classified into kind. Either may be left empty to only
+// match the other.
+func revertsWith(kind error, message string) error {
+	return revert{kind: kind, message: message}
+}
+
+// assertTxError asserts that err is the failure expectedErr describes.
+// expectedErr is either nil for success, a revert, or an error like
+// allday.ErrSeriesClosed that err must match with errors.Is.
+func assertTxError(t *testing.T, expectedErr error, err error) {
+	var expected revert
+	switch {
+	case expectedErr == nil:
+		assert.NoError(t, err)
+	case errors.As(expectedErr, &expected):
+		if expected.kind != nil {
+			assert.ErrorIs(t, err, expected.kind)
+		}
+		if expected.message != "" {
+			assert.ErrorContains(t, err, expected.message)
 		}
+	default:
+		assert.ErrorIs(t, err, expectedErr)
 	}
-
-	_, err = b.CommitBlock()
-	assert.NoError(t, err)
 }
 
 // executeScriptAndCheck executes a script and checks to make sure that it succeeded.
@@ -284,14 +351,38 @@ func executeScriptAndCheck(t *testing.T, b *emulator.Blockchain, script []byte,
 	return result.Value
 }
 
-// readFile reads a file from the file system
-// and returns its contents
-func readFile(path string) []byte {
-	contents, err := ioutil.ReadFile(path)
-	if err != nil {
-		panic(err)
+// executeBuiltScript executes a script from the builders package and checks it succeeded
+func executeBuiltScript(t *testing.T, b *emulator.Blockchain, script builders.Script) cadence.Value {
+	arguments, err := script.EncodedArguments()
+	require.NoError(t, err)
+	return executeScriptAndCheck(t, b, script.Code, arguments)
+}
+
+// latestEvents decodes the AllDay events of the latest block in the order they were emitted
+func latestEvents(t *testing.T, b *emulator.Blockchain, contracts Contracts) []events.Event {
+	block, err := b.GetLatestBlock()
+	require.NoError(t, err)
+
+	decoder := events.NewDecoder(contracts.environment())
+	var flowEvents []flowgo.Event
+	for _, typeID := range decoder.TypeIDs() {
+		blockEvents, err := b.GetEventsByHeight(block.Height, typeID)
+		require.NoError(t, err)
+		flowEvents = append(flowEvents, blockEvents...)
+	}
+	sort.Slice(flowEvents, func(i, j int) bool {
+		if flowEvents[i].TransactionIndex != flowEvents[j].TransactionIndex {
+			return flowEvents[i].TransactionIndex < flowEvents[j].TransactionIndex
+		}
+		return flowEvents[i].EventIndex < flowEvents[j].EventIndex
+	})
+
+	decoded := make([]events.Event, len(flowEvents))
+	for i, event := range flowEvents {
+		decoded[i], err = decoder.DecodePayload(event.Payload)
+		require.NoError(t, err)
 	}
-	return contents
+	return decoded
 }
 
 // cadenceUFix64 returns a UFix64 value
@@ -324,9 +415,9 @@ func setupAllDay(
 	userSigner crypto.Signer,
 	contracts Contracts,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDaySetupAccountTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().SetupAllDayAccount()
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(userAddress)
@@ -337,7 +428,7 @@ func setupAllDay(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, userAddress},
 		[]crypto.Signer{signer, userSigner},
-		false,
+		nil,
 	)
 }
 
@@ -352,21 +443,3 @@ func setupAccount(
 
 	return address, signer
 }
-
-func metadataDict(dict map[string]string) cadence.Dictionary {
-	pairs := []cadence.KeyValuePair{}
-
-	for key, value := range dict {
-		k, err := cadence.NewString(key)
-		if err != nil {
-			panic(err)
-		}
-		v, err := cadence.NewString(value)
-		if err != nil {
-			panic(err)
-		}
-		pairs = append(pairs, cadence.KeyValuePair{Key: k, Value: v})
-	}
-
-	return cadence.NewDictionary(pairs)
-}
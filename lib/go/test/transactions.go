@@ -10,6 +10,8 @@ import (
 	fttemplates "github.com/onflow/flow-ft/lib/go/templates"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
 )
 
 // ------------------------------------------------------------
@@ -42,7 +44,7 @@ func fundAccount(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address},
 		[]crypto.Signer{signer},
-		false,
+		nil,
 	)
 }
 
@@ -54,17 +56,14 @@ func createSeries(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	name string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	nameString, err := cadence.NewString(name)
+	tx, err := contracts.builder().CreateSeries(name)
 	require.NoError(t, err)
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCreateSeriesTransaction(contracts)).
-		SetComputeLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(nameString)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -72,7 +71,7 @@ func createSeries(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -81,15 +80,14 @@ func closeSeries(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	id uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCloseSeriesTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().CloseSeries(id)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(cadence.NewUInt64(id))
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -97,7 +95,7 @@ func closeSeries(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -109,17 +107,14 @@ func createSet(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	name string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	nameString, err := cadence.NewString(name)
+	tx, err := contracts.builder().CreateSet(name)
 	require.NoError(t, err)
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCreateSetTransaction(contracts)).
-		SetComputeLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(nameString)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -127,7 +122,7 @@ func createSet(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -140,18 +135,14 @@ func createPlay(
 	contracts Contracts,
 	classification string,
 	metadata map[string]string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	classificationString, err := cadence.NewString(classification)
+	tx, err := contracts.builder().CreatePlay(classification, metadata)
 	require.NoError(t, err)
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCreatePlayTransaction(contracts)).
-		SetComputeLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(classificationString)
-	tx.AddArgument(metadataDict(metadata))
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -159,7 +150,7 @@ func createPlay(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -169,18 +160,14 @@ func updatePlayDescription(
 	contracts Contracts,
 	playID uint64,
 	description string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayUpdatePlayDescriptionTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().UpdatePlayDescription(playID, description)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	descriptionString, err := cadence.NewString(description)
-	require.NoError(t, err)
-	tx.AddArgument(cadence.NewUInt64(playID))
-	tx.AddArgument(descriptionString)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -188,45 +175,28 @@ func updatePlayDescription(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
 func updatePlayDynamicMetadata(t *testing.T, b *emulator.Blockchain, contracts Contracts, playID uint64,
 	teamName *string, playerFirstName *string, playerLastName *string, playerNumber *string, playerPosition *string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayUpdateDayUpdatePlayDynamicMetadataTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().UpdatePlayDynamicMetadata(playID, teamName, playerFirstName, playerLastName, playerNumber, playerPosition)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
 
-	toOptionalString := func(val *string) cadence.Optional {
-		if val != nil {
-			cdcString, err := cadence.NewString(*val)
-			require.NoError(t, err)
-			return cadence.NewOptional(cdcString)
-		} else {
-			return cadence.NewOptional(nil)
-		}
-	}
-
-	tx.AddArgument(cadence.NewUInt64(playID))
-	tx.AddArgument(toOptionalString(teamName))
-	tx.AddArgument(toOptionalString(playerFirstName))
-	tx.AddArgument(toOptionalString(playerLastName))
-	tx.AddArgument(toOptionalString(playerNumber))
-	tx.AddArgument(toOptionalString(playerPosition))
-
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
 	signAndSubmit(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -243,32 +213,14 @@ func createEdition(
 	maxMintSize *uint64,
 	tier string,
 	parallel *string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tierString, err := cadence.NewString(tier)
+	tx, err := contracts.builder().CreateEdition(seriesID, setID, playID, model.Tier(tier), (*model.Parallel)(parallel), maxMintSize)
 	require.NoError(t, err)
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCreateEditionTransaction(contracts)).
-		SetComputeLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(cadence.NewUInt64(seriesID))
-	tx.AddArgument(cadence.NewUInt64(setID))
-	tx.AddArgument(cadence.NewUInt64(playID))
-	tx.AddArgument(tierString)
-	if parallel != nil {
-		parallelString, err := cadence.NewString(*parallel)
-		require.NoError(t, err)
-		tx.AddArgument(parallelString)
-	} else {
-		tx.AddArgument(cadence.Optional{})
-	}
-	if maxMintSize != nil {
-		tx.AddArgument(cadence.NewUInt64(*maxMintSize))
-	} else {
-		tx.AddArgument(cadence.Optional{})
-	}
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -276,7 +228,7 @@ func createEdition(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -285,15 +237,44 @@ func closeEdition(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	editionID uint64,
-	shouldRevert bool,
+	expectedErr error,
+) {
+	tx, err := contracts.builder().CloseEdition(editionID)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address).
+		AddAuthorizer(contracts.AllDayAddress)
+
+	signer, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
+		[]crypto.Signer{signer, contracts.AllDaySigner},
+		expectedErr,
+	)
+}
+
+// removeEditionIDMap removes the Edition ID map from the AllDay account's
+// storage, leaving the editions as if they were created before it existed
+func removeEditionIDMap(
+	t *testing.T,
+	b *emulator.Blockchain,
+	contracts Contracts,
 ) {
 	tx := flow.NewTransaction().
-		SetScript(loadAllDayCloseEditionTransaction(contracts)).
+		SetScript([]byte(`
+			transaction {
+				prepare(signer: auth(LoadValue) &Account) {
+					signer.storage.load<{String: UInt64}>(from: /storage/AllDayEditionIDBySetPlayTierParallel)
+				}
+			}
+		`)).
 		SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(cadence.NewUInt64(editionID))
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -301,7 +282,32 @@ func closeEdition(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		nil,
+	)
+}
+
+func insertEditionIDs(
+	t *testing.T,
+	b *emulator.Blockchain,
+	contracts Contracts,
+	fromID uint64,
+	toID uint64,
+	expectedErr error,
+) {
+	tx, err := contracts.builder().InsertEditionIDs(fromID, toID)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address).
+		AddAuthorizer(contracts.AllDayAddress)
+
+	signer, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
+		[]crypto.Signer{signer, contracts.AllDaySigner},
+		expectedErr,
 	)
 }
 
@@ -315,21 +321,14 @@ func mintMomentNFT(
 	recipientAddress flow.Address,
 	editionID uint64,
 	serialNumber *uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayMintMomentNFTTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().MintMomentNFT(recipientAddress, editionID, serialNumber)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(cadence.BytesToAddress(recipientAddress.Bytes()))
-	tx.AddArgument(cadence.NewUInt64(editionID))
-	sNumber := cadence.NewOptional(nil)
-	if serialNumber != nil {
-		sNumber = cadence.NewOptional(cadence.NewUInt64(*serialNumber))
-	}
-	tx.AddArgument(sNumber)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -337,7 +336,7 @@ func mintMomentNFT(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -348,32 +347,18 @@ func mintMomentNFTMulti(
 	recipientAddress flow.Address,
 	editionIDs []uint64,
 	serialNumbers []*uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayMintMomentNFTMultiTransaction(contracts)).
-		SetComputeLimit(900).
+	counts := make([]uint64, len(editionIDs))
+	for i := range counts {
+		counts[i] = 1
+	}
+	tx, err := contracts.builder().MintMomentNFTsMulti(recipientAddress, editionIDs, counts, serialNumbers)
+	require.NoError(t, err)
+	tx.SetComputeLimit(900).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(cadence.BytesToAddress(recipientAddress.Bytes()))
-	counts := []cadence.Value{}
-	cEditionIDs := []cadence.Value{}
-	for _, id := range editionIDs {
-		cEditionIDs = append(cEditionIDs, cadence.NewUInt64(id))
-		counts = append(counts, cadence.NewUInt64(1))
-	}
-	cSerialNumbers := []cadence.Value{}
-	for _, sn := range serialNumbers {
-		if sn != nil {
-			cSerialNumbers = append(cSerialNumbers, cadence.NewOptional(cadence.NewUInt64(*sn)))
-		} else {
-			cSerialNumbers = append(cSerialNumbers, cadence.NewOptional(nil))
-		}
-	}
-	tx.AddArgument(cadence.NewArray(cEditionIDs))
-	tx.AddArgument(cadence.NewArray(counts))
-	tx.AddArgument(cadence.NewArray(cSerialNumbers))
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -381,7 +366,7 @@ func mintMomentNFTMulti(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -393,16 +378,14 @@ func transferMomentNFT(
 	senderSigner crypto.Signer,
 	nftID uint64,
 	recipientAddress flow.Address,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayTransferNFTTransaction(contracts)).
-		SetComputeLimit(100).
+	tx, err := contracts.builder().TransferMomentNFT(recipientAddress, nftID)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(senderAddress)
-	tx.AddArgument(cadence.BytesToAddress(recipientAddress.Bytes()))
-	tx.AddArgument(cadence.NewUInt64(nftID))
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -410,7 +393,7 @@ func transferMomentNFT(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, senderAddress},
 		[]crypto.Signer{signer, senderSigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -426,29 +409,14 @@ func createBadge(
 	description string,
 	visible bool,
 	slugV2 string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	slugString, err := cadence.NewString(slug)
-	require.NoError(t, err)
-	titleString, err := cadence.NewString(title)
+	tx, err := contracts.builder().CreateBadge(slug, title, description, visible, slugV2)
 	require.NoError(t, err)
-	descriptionString, err := cadence.NewString(description)
-	require.NoError(t, err)
-	visibleBool := cadence.NewBool(visible)
-	slugV2String, err := cadence.NewString(slugV2)
-	require.NoError(t, err)
-
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayCreateBadgeTransaction(contracts)).
-		SetGasLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(slugString)
-	tx.AddArgument(titleString)
-	tx.AddArgument(descriptionString)
-	tx.AddArgument(visibleBool)
-	tx.AddArgument(slugV2String)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -456,7 +424,7 @@ func createBadge(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -470,75 +438,18 @@ func updateBadge(
 	visible *bool,
 	slugV2 *string,
 	metadata map[string]string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	slugString, err := cadence.NewString(slug)
-	require.NoError(t, err)
-
-	var titleOptional cadence.Optional
-	if title != nil {
-		titleStr, err := cadence.NewString(*title)
-		require.NoError(t, err)
-		titleOptional = cadence.NewOptional(titleStr)
-	} else {
-		titleOptional = cadence.NewOptional(nil)
-	}
-
-	var descriptionOptional cadence.Optional
-	if description != nil {
-		descStr, err := cadence.NewString(*description)
-		require.NoError(t, err)
-		descriptionOptional = cadence.NewOptional(descStr)
-	} else {
-		descriptionOptional = cadence.NewOptional(nil)
-	}
-
-	var visibleOptional cadence.Optional
-	if visible != nil {
-		visibleOptional = cadence.NewOptional(cadence.NewBool(*visible))
-	} else {
-		visibleOptional = cadence.NewOptional(nil)
-	}
-
-	var slugV2Optional cadence.Optional
-	if slugV2 != nil {
-		slugV2Str, err := cadence.NewString(*slugV2)
-		require.NoError(t, err)
-		slugV2Optional = cadence.NewOptional(slugV2Str)
-	} else {
-		slugV2Optional = cadence.NewOptional(nil)
-	}
-
-	var metadataOptional cadence.Optional
+	var optionalMetadata *map[string]string
 	if metadata != nil {
-		pairs := []cadence.KeyValuePair{}
-		for key, value := range metadata {
-			cadenceKey, err := cadence.NewString(key)
-			require.NoError(t, err)
-			cadenceValue, err := cadence.NewString(value)
-			require.NoError(t, err)
-			pairs = append(pairs, cadence.KeyValuePair{
-				Key:   cadenceKey,
-				Value: cadenceValue,
-			})
-		}
-		metadataOptional = cadence.NewOptional(cadence.NewDictionary(pairs))
-	} else {
-		metadataOptional = cadence.NewOptional(nil)
+		optionalMetadata = &metadata
 	}
-
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayUpdateBadgeTransaction(contracts)).
-		SetGasLimit(100).
+	tx, err := contracts.builder().UpdateBadge(slug, title, description, visible, slugV2, optionalMetadata)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(slugString)
-	tx.AddArgument(titleOptional)
-	tx.AddArgument(descriptionOptional)
-	tx.AddArgument(visibleOptional)
-	tx.AddArgument(slugV2Optional)
-	tx.AddArgument(metadataOptional)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -546,7 +457,7 @@ func updateBadge(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -558,37 +469,14 @@ func addBadgeToEntity(
 	entityType string,
 	entityID uint64,
 	metadata map[string]string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	badgeSlugString, err := cadence.NewString(badgeSlug)
-	require.NoError(t, err)
-	entityTypeString, err := cadence.NewString(entityType)
-	require.NoError(t, err)
-	entityIDUint64 := cadence.NewUInt64(entityID)
-
-	pairs := []cadence.KeyValuePair{}
-	for key, value := range metadata {
-		keyStr, err := cadence.NewString(key)
-		require.NoError(t, err)
-		valueStr, err := cadence.NewString(value)
-		require.NoError(t, err)
-		pairs = append(pairs, cadence.KeyValuePair{
-			Key:   keyStr,
-			Value: valueStr,
-		})
-	}
-	metadataDict := cadence.NewDictionary(pairs)
-
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayAddBadgeToEntityTransaction(contracts)).
-		SetGasLimit(100).
+	tx, err := contracts.builder().AddBadgeToEntity(badgeSlug, entityType, entityID, metadata)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(badgeSlugString)
-	tx.AddArgument(entityTypeString)
-	tx.AddArgument(entityIDUint64)
-	tx.AddArgument(metadataDict)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -596,7 +484,7 @@ func addBadgeToEntity(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -607,23 +495,14 @@ func removeBadgeFromEntity(
 	badgeSlug string,
 	entityType string,
 	entityID uint64,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	badgeSlugString, err := cadence.NewString(badgeSlug)
-	require.NoError(t, err)
-	entityTypeString, err := cadence.NewString(entityType)
+	tx, err := contracts.builder().RemoveBadgeFromEntity(badgeSlug, entityType, entityID)
 	require.NoError(t, err)
-	entityIDUint64 := cadence.NewUInt64(entityID)
-
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayRemoveBadgeFromEntityTransaction(contracts)).
-		SetGasLimit(100).
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(badgeSlugString)
-	tx.AddArgument(entityTypeString)
-	tx.AddArgument(entityIDUint64)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -631,7 +510,7 @@ func removeBadgeFromEntity(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
 
@@ -640,18 +519,40 @@ func deleteBadge(
 	b *emulator.Blockchain,
 	contracts Contracts,
 	badgeSlug string,
-	shouldRevert bool,
+	expectedErr error,
 ) {
-	badgeSlugString, err := cadence.NewString(badgeSlug)
+	tx, err := contracts.builder().DeleteBadge(badgeSlug)
 	require.NoError(t, err)
+	tx.SetComputeLimit(100).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address).
+		AddAuthorizer(contracts.AllDayAddress)
 
-	tx := flow.NewTransaction().
-		SetScript(loadAllDayDeleteBadgeTransaction(contracts)).
-		SetGasLimit(100).
+	signer, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
+		[]crypto.Signer{signer, contracts.AllDaySigner},
+		expectedErr,
+	)
+}
+
+func indexBadgeEntities(
+	t *testing.T,
+	b *emulator.Blockchain,
+	contracts Contracts,
+	entityType string,
+	offset uint64,
+	limit uint64,
+	expectedErr error,
+) {
+	tx, err := contracts.builder().IndexBadgeEntities(entityType, offset, limit)
+	require.NoError(t, err)
+	tx.SetComputeLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
 		AddAuthorizer(contracts.AllDayAddress)
-	tx.AddArgument(badgeSlugString)
 
 	signer, err := b.ServiceKey().Signer()
 	require.NoError(t, err)
@@ -659,6 +560,6 @@ func deleteBadge(
 		t, b, tx,
 		[]flow.Address{b.ServiceKey().Address, contracts.AllDayAddress},
 		[]crypto.Signer{signer, contracts.AllDaySigner},
-		shouldRevert,
+		expectedErr,
 	)
 }
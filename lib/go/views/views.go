@@ -0,0 +1,193 @@
+// Package views has a Go type for each MetadataViews view the AllDay NFT
+// and contract resolve, and decodes the views read_moment_nft_metadata.cdc
+// returns:
+//
+//	value, err := backend.ExecuteScript(ctx, b.ReadMomentNFTMetadata(owner, id))
+//	metadata, err := views.DecodeMomentMetadata(value)
+//	fmt.Println(metadata.Display.Name, metadata.Display.Thumbnail.URI())
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/codec"
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+// File is a MetadataViews.File, either an HTTPFile with a URL or an IPFSFile
+// with a CID and an optional path
+type File struct {
+	URL  string  `cadence:"url,optional" json:"url,omitempty"`
+	CID  string  `cadence:"cid,optional" json:"cid,omitempty"`
+	Path *string `cadence:"path,optional" json:"path,omitempty"`
+}
+
+// URI mirrors File.uri
+func (f File) URI() string {
+	switch {
+	case f.CID == "":
+		return f.URL
+	case f.Path != nil:
+		return "ipfs://" + f.CID + "/" + *f.Path
+	}
+	return "ipfs://" + f.CID
+}
+
+// Display is MetadataViews.Display
+type Display struct {
+	Name        string `cadence:"name" json:"name"`
+	Description string `cadence:"description" json:"description"`
+	Thumbnail   File   `cadence:"thumbnail" json:"thumbnail"`
+}
+
+// Edition is MetadataViews.Edition
+type Edition struct {
+	Name   *string `cadence:"name" json:"name"`
+	Number uint64  `cadence:"number" json:"number"`
+	// Max is nil if the edition can be minted without limit
+	Max *uint64 `cadence:"max" json:"max"`
+}
+
+// Editions is MetadataViews.Editions
+type Editions struct {
+	InfoList []Edition `cadence:"infoList" json:"infoList"`
+}
+
+// ExternalURL is MetadataViews.ExternalURL
+type ExternalURL struct {
+	URL string `cadence:"url" json:"url"`
+}
+
+// Media is MetadataViews.Media
+type Media struct {
+	File      File   `cadence:"file" json:"file"`
+	MediaType string `cadence:"mediaType" json:"mediaType"`
+}
+
+// Medias is MetadataViews.Medias
+type Medias struct {
+	Items []Media `cadence:"items" json:"items"`
+}
+
+// NFTCollectionData is MetadataViews.NFTCollectionData, without its
+// createEmptyCollectionFunction. Paths are strings such as
+// "/public/AllDayNFTCollection", and types are type IDs.
+type NFTCollectionData struct {
+	StoragePath      string `cadence:"storagePath" json:"storagePath"`
+	PublicPath       string `cadence:"publicPath" json:"publicPath"`
+	PublicCollection string `cadence:"publicCollection" json:"publicCollection"`
+	PublicLinkedType string `cadence:"publicLinkedType" json:"publicLinkedType"`
+}
+
+// NFTCollectionDisplay is MetadataViews.NFTCollectionDisplay
+type NFTCollectionDisplay struct {
+	Name        string                 `cadence:"name" json:"name"`
+	Description string                 `cadence:"description" json:"description"`
+	ExternalURL ExternalURL            `cadence:"externalURL" json:"externalURL"`
+	SquareImage Media                  `cadence:"squareImage" json:"squareImage"`
+	BannerImage Media                  `cadence:"bannerImage" json:"bannerImage"`
+	Socials     map[string]ExternalURL `cadence:"socials" json:"socials"`
+}
+
+// Royalty is MetadataViews.Royalty
+type Royalty struct {
+	// Receiver is the address of the receiver capability
+	Receiver    flow.Address `cadence:"receiver" json:"receiver"`
+	Cut         float64      `cadence:"cut" json:"cut"`
+	Description string       `cadence:"description" json:"description"`
+}
+
+// Royalties is MetadataViews.Royalties
+type Royalties struct {
+	CutInfos []Royalty `cadence:"cutInfos" json:"cutInfos"`
+}
+
+// Serial is MetadataViews.Serial
+type Serial struct {
+	Number uint64 `cadence:"number" json:"number"`
+}
+
+// Rarity is MetadataViews.Rarity
+type Rarity struct {
+	Score       *float64 `cadence:"score" json:"score,omitempty"`
+	Max         *float64 `cadence:"max" json:"max,omitempty"`
+	Description *string  `cadence:"description" json:"description,omitempty"`
+}
+
+// Trait is MetadataViews.Trait
+type Trait struct {
+	Name string `cadence:"name" json:"name"`
+	// Value is a string, bool or uint64 for those Cadence types, a
+	// []model.Badge for the badges trait, or the cadence.Value otherwise
+	Value       any     `cadence:"value" json:"value"`
+	DisplayType *string `cadence:"displayType" json:"displayType,omitempty"`
+	Rarity      *Rarity `cadence:"rarity" json:"rarity,omitempty"`
+}
+
+// Traits is MetadataViews.Traits
+type Traits struct {
+	Traits []Trait `cadence:"traits" json:"traits"`
+}
+
+// Map returns the value of each trait keyed by its name
+func (t Traits) Map() map[string]any {
+	values := make(map[string]any, len(t.Traits))
+	for _, trait := range t.Traits {
+		values[trait.Name] = trait.Value
+	}
+	return values
+}
+
+// MomentMetadata holds the views of a moment, in the order
+// read_moment_nft_metadata.cdc returns them. NFTCollectionData is last, so
+// that the earlier views keep their positions in the script's result.
+type MomentMetadata struct {
+	Display              Display              `cadence:"display" json:"display"`
+	Editions             Editions             `cadence:"editions" json:"editions"`
+	ExternalURL          ExternalURL          `cadence:"externalURL" json:"externalURL"`
+	Medias               Medias               `cadence:"medias" json:"medias"`
+	NFTCollectionDisplay NFTCollectionDisplay `cadence:"nftCollectionDisplay" json:"nftCollectionDisplay"`
+	Royalties            Royalties            `cadence:"royalties" json:"royalties"`
+	Serial               Serial               `cadence:"serial" json:"serial"`
+	Traits               Traits               `cadence:"traits" json:"traits"`
+	NFTCollectionData    NFTCollectionData    `cadence:"nftCollectionData" json:"nftCollectionData"`
+}
+
+// DecodeMomentMetadata decodes the array of views read_moment_nft_metadata.cdc
+// returns
+func DecodeMomentMetadata(value cadence.Value) (MomentMetadata, error) {
+	var metadata MomentMetadata
+	if err := codec.Decode(value, &metadata); err != nil {
+		return metadata, fmt.Errorf("views: decode MomentMetadata: %w", err)
+	}
+	for i, trait := range metadata.Traits.Traits {
+		value, err := traitValue(trait.Value)
+		if err != nil {
+			return metadata, fmt.Errorf("views: decode MomentMetadata: trait %s: %w", trait.Name, err)
+		}
+		metadata.Traits.Traits[i].Value = value
+	}
+	return metadata, nil
+}
+
+// traitValue converts the value of a trait into a Go value
+func traitValue(value any) (any, error) {
+	switch value := value.(type) {
+	case cadence.String:
+		return string(value), nil
+	case cadence.Bool:
+		return bool(value), nil
+	case cadence.UInt64:
+		return uint64(value), nil
+	case cadence.Array:
+		// the badges trait is an [AllDay.Badge]
+		if len(value.Values) > 0 && value.Values[0].Type() != nil && strings.HasSuffix(value.Values[0].Type().ID(), "AllDay.Badge") {
+			return model.DecodeBadges(value)
+		}
+	}
+	return value, nil
+}
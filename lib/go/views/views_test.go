@@ -0,0 +1,61 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nfl-smart-contracts/lib/go/model"
+)
+
+func structValue(name string, fields map[string]cadence.Value) cadence.Struct {
+	var typeFields []cadence.Field
+	var values []cadence.Value
+	for identifier, value := range fields {
+		typeFields = append(typeFields, cadence.Field{Identifier: identifier, Type: value.Type()})
+		values = append(values, value)
+	}
+	return cadence.NewStruct(values).WithType(cadence.NewStructType(nil, name, typeFields, nil))
+}
+
+func TestFileURI(t *testing.T) {
+	path := "image.png"
+	assert.Equal(t, "https://nflallday.com/", File{URL: "https://nflallday.com/"}.URI())
+	assert.Equal(t, "ipfs://bafy", File{CID: "bafy"}.URI())
+	assert.Equal(t, "ipfs://bafy/image.png", File{CID: "bafy", Path: &path}.URI())
+}
+
+func TestTraitValue(t *testing.T) {
+	badge := structValue("A.01.AllDay.Badge", map[string]cadence.Value{
+		"slug":        cadence.String("rookie"),
+		"title":       cadence.String("Rookie"),
+		"description": cadence.String("First season"),
+		"visible":     cadence.Bool(true),
+		"slugV2":      cadence.String("rookie-v2"),
+		"metadata":    cadence.NewDictionary(nil),
+	})
+	tests := []struct {
+		value cadence.Value
+		want  any
+	}{
+		{cadence.String("COMMON"), "COMMON"},
+		{cadence.NewUInt64(7), uint64(7)},
+		{cadence.Bool(true), true},
+		{cadence.NewArray([]cadence.Value{badge}), []model.Badge{{
+			Slug: "rookie", Title: "Rookie", Description: "First season", Visible: true, SlugV2: "rookie-v2", Metadata: map[string]string{},
+		}}},
+		{cadence.NewInt(3), cadence.NewInt(3)},
+	}
+	for _, test := range tests {
+		got, err := traitValue(test.value)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func TestDecodeMomentMetadataErrors(t *testing.T) {
+	_, err := DecodeMomentMetadata(cadence.NewArray([]cadence.Value{cadence.String("display")}))
+	assert.EqualError(t, err, "views: decode MomentMetadata: codec: cannot decode 1 elements into views.MomentMetadata with 9 fields")
+}
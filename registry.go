@@ -0,0 +1,125 @@
+package nfl
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// FS holds the AllDay contracts and every script and transaction in this repository
+//
+//go:embed contracts/*.cdc scripts transactions
+var FS embed.FS
+
+// Kind is the type of a Cadence file
+type Kind string
+
+const (
+	KindContract    Kind = "contract"
+	KindTransaction Kind = "transaction"
+	KindScript      Kind = "script"
+)
+
+// Category is the audience of a Cadence file
+type Category string
+
+const (
+	// CategoryAdmin files are deployed or signed by the AllDay admin account
+	CategoryAdmin Category = "admin"
+	// CategoryUser files can be signed or executed by anyone
+	CategoryUser Category = "user"
+)
+
+// Template is a Cadence file in FS
+type Template struct {
+	// Name is the file name without its extension, e.g. create_edition
+	Name     string
+	Kind     Kind
+	Category Category
+	// Group is the directory the file lives in, e.g. editions
+	Group string
+	// Path is the path of the file relative to the repository root
+	Path string
+}
+
+// Code returns the contents of the template, with its imports unresolved
+func (t Template) Code() []byte {
+	code, err := FS.ReadFile(t.Path)
+	if err != nil {
+		panic(err)
+	}
+	return code
+}
+
+var registry = loadRegistry()
+
+// loadRegistry walks FS and classifies every .cdc file by its location
+func loadRegistry() []Template {
+	var templates []Template
+	err := fs.WalkDir(FS, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".cdc" {
+			return nil
+		}
+
+		dir, file := path.Split(p)
+		parts := strings.Split(strings.TrimSuffix(dir, "/"), "/")
+		template := Template{
+			Name:  strings.TrimSuffix(file, ".cdc"),
+			Group: parts[len(parts)-1],
+			Path:  p,
+		}
+		switch parts[0] {
+		case "contracts":
+			template.Kind = KindContract
+			template.Category = CategoryAdmin
+			template.Group = ""
+		case "transactions":
+			template.Kind = KindTransaction
+			template.Category = Category(parts[1])
+		case "scripts":
+			template.Kind = KindScript
+			template.Category = CategoryUser
+		}
+		templates = append(templates, template)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Path < templates[j].Path
+	})
+	return templates
+}
+
+// Templates returns every contract, transaction and script, sorted by path
+func Templates() []Template {
+	return append([]Template(nil), registry...)
+}
+
+// TemplatesOf returns the templates of the given kind, sorted by path
+func TemplatesOf(kind Kind) []Template {
+	var templates []Template
+	for _, template := range registry {
+		if template.Kind == kind {
+			templates = append(templates, template)
+		}
+	}
+	return templates
+}
+
+// LookupTemplate returns the template at path
+func LookupTemplate(path string) (Template, bool) {
+	for _, template := range registry {
+		if template.Path == path {
+			return template, true
+		}
+	}
+	return Template{}, false
+}
@@ -0,0 +1,64 @@
+package nfl
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryListsEveryFile(t *testing.T) {
+	var onDisk []string
+	for _, root := range []string{"scripts", "transactions"} {
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && filepath.Ext(p) == ".cdc" {
+				onDisk = append(onDisk, filepath.ToSlash(p))
+			}
+			return err
+		})
+		require.NoError(t, err)
+	}
+	onDisk = append(onDisk, "contracts/AllDay.cdc", "contracts/PackNFT.cdc")
+
+	var registered []string
+	for _, template := range Templates() {
+		registered = append(registered, template.Path)
+
+		content, err := os.ReadFile(template.Path)
+		require.NoError(t, err)
+		assert.Equal(t, content, template.Code())
+	}
+	assert.ElementsMatch(t, onDisk, registered)
+}
+
+func TestRegistryClassification(t *testing.T) {
+	template, ok := LookupTemplate("transactions/admin/editions/create_edition.cdc")
+	require.True(t, ok)
+	assert.Equal(t, Template{
+		Name:     "create_edition",
+		Kind:     KindTransaction,
+		Category: CategoryAdmin,
+		Group:    "editions",
+		Path:     "transactions/admin/editions/create_edition.cdc",
+	}, template)
+
+	template, ok = LookupTemplate("transactions/user/setup_switchboard_account.cdc")
+	require.True(t, ok)
+	assert.Equal(t, CategoryUser, template.Category)
+
+	template, ok = LookupTemplate("scripts/nfts/read_moment_nft_supply.cdc")
+	require.True(t, ok)
+	assert.Equal(t, KindScript, template.Kind)
+	assert.Equal(t, "nfts", template.Group)
+
+	contracts := TemplatesOf(KindContract)
+	require.Len(t, contracts, 2)
+	assert.Equal(t, AllDayContract, contracts[0].Code())
+	assert.Equal(t, PackNFTContract, contracts[1].Code())
+
+	_, ok = LookupTemplate("scripts/edition/read_all_editions.cdc")
+	assert.False(t, ok)
+}